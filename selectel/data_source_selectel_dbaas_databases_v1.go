@@ -0,0 +1,100 @@
+package selectel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/selectel/dbaas-go"
+)
+
+func dataSourceDBaaSDatabasesV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDBaaSDatabasesV1Read,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"datastore_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"databases": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"datastore_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDBaaSDatabasesV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dbaasClient, diagErr := getDBaaSClient(d, meta)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	datastoreID := d.Get("datastore_id").(string)
+	databases, err := dbaasClient.Databases(ctx, &dbaas.DatabaseQueryParams{
+		ProjectID:   d.Get("project_id").(string),
+		DatastoreID: datastoreID,
+	})
+	if err != nil {
+		return diag.FromErr(errGettingObjects(objectDatabases, err))
+	}
+
+	databaseIDs := []string{}
+	databasesList := []interface{}{}
+	for _, database := range databases {
+		if datastoreID != "" && database.DatastoreID != datastoreID {
+			continue
+		}
+		databaseIDs = append(databaseIDs, database.ID)
+		databasesList = append(databasesList, map[string]interface{}{
+			"id":           database.ID,
+			"name":         database.Name,
+			"datastore_id": database.DatastoreID,
+			"owner_id":     database.OwnerID,
+			"status":       string(database.Status),
+		})
+	}
+
+	if err := d.Set("databases", databasesList); err != nil {
+		return diag.FromErr(err)
+	}
+	checksum, err := stringListChecksum(databaseIDs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(checksum)
+
+	return nil
+}
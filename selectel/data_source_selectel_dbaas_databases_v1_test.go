@@ -0,0 +1,104 @@
+package selectel
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/selectel/go-selvpcclient/v4/selvpcclient/resell/v2/projects"
+)
+
+func TestAccDBaaSDatabasesV1Basic(t *testing.T) {
+	var project projects.Project
+
+	projectName := acctest.RandomWithPrefix("tf-acc")
+	datastoreName := acctest.RandomWithPrefix("tf-acc-ds")
+	userName := RandomWithPrefix("tf_acc_user")
+	userPassword := acctest.RandomWithPrefix("tf-acc-pass")
+	databaseName := RandomWithPrefix("tf_acc_db")
+	nodeCount := 1
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSelectelPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckVPCV2ProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDBaaSDatabasesAndUsersV1Basic(projectName, datastoreName, userName, userPassword, databaseName, nodeCount),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCV2ProjectExists("selectel_vpc_project_v2.project_tf_acc_test_1", &project),
+					resource.TestCheckResourceAttr("data.selectel_dbaas_databases_v1.databases_tf_acc_test_1", "databases.#", "1"),
+					resource.TestCheckResourceAttr("data.selectel_dbaas_databases_v1.databases_tf_acc_test_1", "databases.0.name", databaseName),
+					resource.TestCheckResourceAttrPair(
+						"data.selectel_dbaas_databases_v1.databases_tf_acc_test_1", "databases.0.owner_id",
+						"selectel_dbaas_user_v1.user_tf_acc_test_1", "id",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccDBaaSDatabasesAndUsersV1Basic(projectName, datastoreName, userName, userPassword, databaseName string, nodeCount int) string {
+	return fmt.Sprintf(`
+resource "selectel_vpc_project_v2" "project_tf_acc_test_1" {
+  name        = "%s"
+}
+
+resource "selectel_vpc_subnet_v2" "subnet_tf_acc_test_1" {
+  project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+  region     = "ru-3"
+}
+
+data "selectel_dbaas_datastore_type_v1" "dt" {
+  project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+  region = "ru-3"
+  filter {
+    engine = "postgresql"
+    version = "12"
+  }
+}
+
+resource "selectel_dbaas_postgresql_datastore_v1" "datastore_tf_acc_test_1" {
+  name = "%s"
+  project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+  region = "ru-3"
+  type_id = "${data.selectel_dbaas_datastore_type_v1.dt.datastore_types[0].id}"
+  subnet_id = "${selectel_vpc_subnet_v2.subnet_tf_acc_test_1.subnet_id}"
+  node_count = "%d"
+  flavor {
+    vcpus = 2
+    ram = 4096
+    disk = 32
+  }
+}
+
+resource "selectel_dbaas_user_v1" "user_tf_acc_test_1" {
+  project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+  region = "ru-3"
+  datastore_id = "${selectel_dbaas_postgresql_datastore_v1.datastore_tf_acc_test_1.id}"
+  name = "%s"
+  password = "%s"
+}
+
+resource "selectel_dbaas_postgresql_database_v1" "database_tf_acc_test_1" {
+  project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+  region = "ru-3"
+  datastore_id = "${selectel_dbaas_postgresql_datastore_v1.datastore_tf_acc_test_1.id}"
+  name = "%s"
+  owner_id = "${selectel_dbaas_user_v1.user_tf_acc_test_1.id}"
+}
+
+data "selectel_dbaas_databases_v1" "databases_tf_acc_test_1" {
+  project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+  region = "ru-3"
+  datastore_id = "${selectel_dbaas_postgresql_database_v1.database_tf_acc_test_1.datastore_id}"
+}
+
+data "selectel_dbaas_users_v1" "users_tf_acc_test_1" {
+  project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+  region = "ru-3"
+  datastore_id = "${selectel_dbaas_user_v1.user_tf_acc_test_1.datastore_id}"
+}`, projectName, datastoreName, nodeCount, userName, userPassword, databaseName)
+}
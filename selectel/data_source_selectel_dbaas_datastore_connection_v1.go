@@ -0,0 +1,258 @@
+package selectel
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/selectel/dbaas-go"
+)
+
+const (
+	dbaasCACertificateURL = "https://storage.dbaas.selcloud.ru/CA.pem"
+
+	postgreSQLPort       = 5432
+	postgreSQLPoolerPort = 6432
+	mySQLPort            = 3306
+	redisPort            = 6380
+	kafkaPort            = 9093
+)
+
+func dataSourceDBaaSDatastoreConnectionV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDBaaSDatastoreConnectionV1Read,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"datastore_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      masterRole,
+				ValidateFunc: validation.StringInSlice([]string{masterRole, replicaRole}, true),
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"database_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"pooler_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"uri": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"pooler_uri": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"bootstrap_servers": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ca_certificate_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDBaaSDatastoreConnectionV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dbaasClient, diagErr := getDBaaSClient(d, meta)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	datastoreID := d.Get("datastore_id").(string)
+
+	log.Print(msgGet(objectDatastore, datastoreID))
+	datastore, err := dbaasClient.Datastore(ctx, datastoreID)
+	if err != nil {
+		return diag.FromErr(errGettingObject(objectDatastore, datastoreID, err))
+	}
+
+	datastoreType, err := dbaasClient.DatastoreType(ctx, datastore.TypeID)
+	if err != nil {
+		return diag.FromErr(errGettingObject(objectDatastoreTypes, datastore.TypeID, err))
+	}
+
+	role := d.Get("role").(string)
+	host, ok := getDatastoreConnectionHost(datastore, role)
+	if !ok {
+		return diag.FromErr(errGettingObject(objectDatastore, datastoreID, fmt.Errorf(
+			"datastore has no connection address for the %s role", role,
+		)))
+	}
+
+	connection := dbaasConnection{
+		engine:   datastoreType.Engine,
+		host:     host,
+		user:     d.Get("user_name").(string),
+		password: d.Get("password").(string),
+		database: d.Get("database_name").(string),
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", datastoreID, strings.ToUpper(role)))
+	d.Set("engine", datastoreType.Engine)
+	d.Set("host", host)
+	d.Set("port", connection.port())
+	d.Set("uri", connection.uri())
+	d.Set("ca_certificate_url", dbaasCACertificateURL)
+
+	if datastoreType.Engine == postgreSQLDatastoreType && datastore.Pooler.Mode != "" {
+		d.Set("pooler_port", postgreSQLPoolerPort)
+		d.Set("pooler_uri", connection.poolerURI())
+	} else {
+		d.Set("pooler_port", 0)
+		d.Set("pooler_uri", "")
+	}
+
+	if datastoreType.Engine == kafkaDatastoreType {
+		d.Set("bootstrap_servers", getDatastoreKafkaBootstrapServers(datastore))
+	} else {
+		d.Set("bootstrap_servers", "")
+	}
+
+	return nil
+}
+
+// getDatastoreConnectionHost returns the address for the role from the datastore
+// connections. The API may return the role keys in upper or lower case.
+func getDatastoreConnectionHost(datastore dbaas.Datastore, role string) (string, bool) {
+	for _, key := range []string{role, strings.ToUpper(role), strings.ToLower(role)} {
+		if host, ok := datastore.Connection[key]; ok && host != "" {
+			return host, true
+		}
+	}
+
+	return "", false
+}
+
+// getDatastoreKafkaBootstrapServers returns a comma-separated list of Kafka
+// brokers built from all datastore connection addresses.
+func getDatastoreKafkaBootstrapServers(datastore dbaas.Datastore) string {
+	hosts := map[string]struct{}{}
+	for _, host := range datastore.Connection {
+		if host != "" {
+			hosts[host] = struct{}{}
+		}
+	}
+
+	servers := make([]string, 0, len(hosts))
+	for host := range hosts {
+		servers = append(servers, net.JoinHostPort(host, strconv.Itoa(kafkaPort)))
+	}
+	sort.Strings(servers)
+
+	return strings.Join(servers, ",")
+}
+
+type dbaasConnection struct {
+	engine   string
+	host     string
+	user     string
+	password string
+	database string
+}
+
+func (c dbaasConnection) port() int {
+	switch c.engine {
+	case postgreSQLDatastoreType:
+		return postgreSQLPort
+	case mySQLDatastoreType, mySQLNativeDatastoreType:
+		return mySQLPort
+	case redisDatastoreType:
+		return redisPort
+	case kafkaDatastoreType:
+		return kafkaPort
+	default:
+		return 0
+	}
+}
+
+func (c dbaasConnection) uri() string {
+	return c.uriWithPort(c.port())
+}
+
+func (c dbaasConnection) poolerURI() string {
+	return c.uriWithPort(postgreSQLPoolerPort)
+}
+
+func (c dbaasConnection) uriWithPort(port int) string {
+	u := url.URL{
+		Host: net.JoinHostPort(c.host, strconv.Itoa(port)),
+	}
+
+	switch c.engine {
+	case postgreSQLDatastoreType:
+		u.Scheme = "postgresql"
+		u.RawQuery = "sslmode=verify-full"
+	case mySQLDatastoreType, mySQLNativeDatastoreType:
+		u.Scheme = "mysql"
+	case redisDatastoreType:
+		// Redis datastores use TLS and only have a password.
+		u.Scheme = "rediss"
+		if c.password != "" {
+			u.User = url.UserPassword("", c.password)
+		}
+
+		return u.String()
+	default:
+		return ""
+	}
+
+	if c.user != "" {
+		if c.password != "" {
+			u.User = url.UserPassword(c.user, c.password)
+		} else {
+			u.User = url.User(c.user)
+		}
+	}
+	if c.database != "" {
+		u.Path = "/" + c.database
+	}
+
+	return u.String()
+}
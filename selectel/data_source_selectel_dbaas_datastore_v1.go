@@ -0,0 +1,121 @@
+package selectel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/selectel/dbaas-go"
+)
+
+var ErrDatastoreNotFound = errors.New("datastore not found")
+
+func dataSourceDBaaSDatastoreV1() *schema.Resource {
+	datastoreSchema := dataSourceDBaaSDatastoreV1AttributesSchema()
+	delete(datastoreSchema, "id")
+	datastoreSchema["project_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	datastoreSchema["region"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	datastoreSchema["datastore_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"datastore_id", "name"},
+	}
+	datastoreSchema["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"datastore_id", "name"},
+	}
+	datastoreSchema["type_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceDBaaSDatastoreV1Read,
+		Schema:      datastoreSchema,
+	}
+}
+
+func dataSourceDBaaSDatastoreV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dbaasClient, diagErr := getDBaaSClient(d, meta)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	var (
+		datastore dbaas.Datastore
+		err       error
+	)
+	if datastoreID, ok := d.GetOk("datastore_id"); ok {
+		datastore, err = getDatastoreByID(ctx, dbaasClient, datastoreID.(string), d.Get("type_id").(string))
+	} else {
+		datastore, err = getDatastoreByName(ctx, dbaasClient, d.Get("project_id").(string), d.Get("name").(string), d.Get("type_id").(string))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(datastore.ID)
+	d.Set("datastore_id", datastore.ID)
+	for key, value := range flattenDBaaSDatastore(datastore) {
+		if key == "id" {
+			continue
+		}
+		if err := d.Set(key, value); err != nil {
+			log.Print(errSettingComplexAttr(key, err))
+		}
+	}
+
+	return nil
+}
+
+func getDatastoreByID(ctx context.Context, client *dbaas.API, datastoreID, typeID string) (dbaas.Datastore, error) {
+	log.Print(msgGet(objectDatastore, datastoreID))
+	datastore, err := client.Datastore(ctx, datastoreID)
+	if err != nil {
+		return dbaas.Datastore{}, errGettingObject(objectDatastore, datastoreID, err)
+	}
+	if typeID != "" && datastore.TypeID != typeID {
+		return dbaas.Datastore{}, errGettingObject(objectDatastore, datastoreID, fmt.Errorf(
+			"datastore has type %s, expected %s", datastore.TypeID, typeID,
+		))
+	}
+
+	return datastore, nil
+}
+
+func getDatastoreByName(ctx context.Context, client *dbaas.API, projectID, name, typeID string) (dbaas.Datastore, error) {
+	log.Print(msgGet(objectDatastore, name))
+	datastores, err := client.Datastores(ctx, &dbaas.DatastoreQueryParams{
+		ProjectID: projectID,
+		Name:      name,
+		TypeID:    typeID,
+	})
+	if err != nil {
+		return dbaas.Datastore{}, errGettingObject(objectDatastore, name, err)
+	}
+
+	datastores = filterDatastores(datastores, datastoreSearchFilter{name: name, typeID: typeID})
+	switch len(datastores) {
+	case 0:
+		return dbaas.Datastore{}, errGettingObject(objectDatastore, name, ErrDatastoreNotFound)
+	case 1:
+		return datastores[0], nil
+	default:
+		return dbaas.Datastore{}, errGettingObject(objectDatastore, name, fmt.Errorf(
+			"found %d datastores with this name, set type_id or use datastore_id", len(datastores),
+		))
+	}
+}
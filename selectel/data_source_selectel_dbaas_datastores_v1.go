@@ -0,0 +1,250 @@
+package selectel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/selectel/dbaas-go"
+)
+
+type datastoreSearchFilter struct {
+	name   string
+	typeID string
+	status string
+}
+
+func dataSourceDBaaSDatastoresV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDBaaSDatastoresV1Read,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"type_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"datastores": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: dataSourceDBaaSDatastoreV1AttributesSchema(),
+				},
+			},
+		},
+	}
+}
+
+// dataSourceDBaaSDatastoreV1AttributesSchema returns computed datastore attributes
+// shared by the selectel_dbaas_datastore_v1 and selectel_dbaas_datastores_v1 data sources.
+func dataSourceDBaaSDatastoreV1AttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"type_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"subnet_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"flavor_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"node_count": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"connections": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"flavor": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"vcpus": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"ram": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"disk": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"disk_type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"instances": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"role": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"floating_ip": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDBaaSDatastoresV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dbaasClient, diagErr := getDBaaSClient(d, meta)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	filter := expandDatastoreSearchFilter(d.Get("filter").(*schema.Set))
+
+	datastores, err := dbaasClient.Datastores(ctx, &dbaas.DatastoreQueryParams{
+		ProjectID: d.Get("project_id").(string),
+		TypeID:    filter.typeID,
+	})
+	if err != nil {
+		return diag.FromErr(errGettingObjects(objectDatastores, err))
+	}
+
+	datastores = filterDatastores(datastores, filter)
+
+	datastoreIDs := []string{}
+	for _, datastore := range datastores {
+		datastoreIDs = append(datastoreIDs, datastore.ID)
+	}
+
+	if err := d.Set("datastores", flattenDBaaSDatastores(datastores)); err != nil {
+		return diag.FromErr(err)
+	}
+	checksum, err := stringListChecksum(datastoreIDs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(checksum)
+
+	return nil
+}
+
+func expandDatastoreSearchFilter(filterSet *schema.Set) datastoreSearchFilter {
+	filter := datastoreSearchFilter{}
+	if filterSet.Len() == 0 {
+		return filter
+	}
+
+	resourceFilterMap := filterSet.List()[0].(map[string]interface{})
+
+	if name, ok := resourceFilterMap["name"]; ok {
+		filter.name = name.(string)
+	}
+	if typeID, ok := resourceFilterMap["type_id"]; ok {
+		filter.typeID = typeID.(string)
+	}
+	if status, ok := resourceFilterMap["status"]; ok {
+		filter.status = status.(string)
+	}
+
+	return filter
+}
+
+func filterDatastores(datastores []dbaas.Datastore, filter datastoreSearchFilter) []dbaas.Datastore {
+	filteredDatastores := []dbaas.Datastore{}
+	for _, datastore := range datastores {
+		if filter.name != "" && datastore.Name != filter.name {
+			continue
+		}
+		if filter.typeID != "" && datastore.TypeID != filter.typeID {
+			continue
+		}
+		if filter.status != "" && string(datastore.Status) != filter.status {
+			continue
+		}
+		filteredDatastores = append(filteredDatastores, datastore)
+	}
+
+	return filteredDatastores
+}
+
+func flattenDBaaSDatastore(datastore dbaas.Datastore) map[string]interface{} {
+	return map[string]interface{}{
+		"id":          datastore.ID,
+		"name":        datastore.Name,
+		"type_id":     datastore.TypeID,
+		"subnet_id":   datastore.SubnetID,
+		"flavor_id":   datastore.FlavorID,
+		"node_count":  datastore.NodeCount,
+		"status":      string(datastore.Status),
+		"enabled":     datastore.Enabled,
+		"connections": datastore.Connection,
+		"flavor": []interface{}{
+			map[string]interface{}{
+				"vcpus":     datastore.Flavor.Vcpus,
+				"ram":       datastore.Flavor.RAM,
+				"disk":      datastore.Flavor.Disk,
+				"disk_type": string(datastore.Flavor.DiskType),
+			},
+		},
+		"instances": resourceDBaaSDatastoreV1InstancesToList(datastore.Instances),
+	}
+}
+
+func flattenDBaaSDatastores(datastores []dbaas.Datastore) []interface{} {
+	datastoresList := make([]interface{}, len(datastores))
+	for i, datastore := range datastores {
+		datastoresList[i] = flattenDBaaSDatastore(datastore)
+	}
+
+	return datastoresList
+}
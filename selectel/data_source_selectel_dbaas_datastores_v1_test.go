@@ -0,0 +1,119 @@
+package selectel
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/selectel/dbaas-go"
+	"github.com/selectel/go-selvpcclient/v4/selvpcclient/resell/v2/projects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccDBaaSDatastoresV1Basic(t *testing.T) {
+	var project projects.Project
+
+	projectName := acctest.RandomWithPrefix("tf-acc")
+	datastoreName := acctest.RandomWithPrefix("tf-acc-ds")
+	nodeCount := 1
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSelectelPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckVPCV2ProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDBaaSDatastoresV1Basic(projectName, datastoreName, nodeCount),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCV2ProjectExists("selectel_vpc_project_v2.project_tf_acc_test_1", &project),
+					resource.TestCheckResourceAttr("data.selectel_dbaas_datastores_v1.datastores_tf_acc_test_1", "datastores.#", "1"),
+					resource.TestCheckResourceAttr("data.selectel_dbaas_datastores_v1.datastores_tf_acc_test_1", "datastores.0.name", datastoreName),
+					resource.TestCheckResourceAttr("data.selectel_dbaas_datastores_v1.datastores_tf_acc_test_1", "datastores.0.status", string(dbaas.StatusActive)),
+				),
+			},
+		},
+	})
+}
+
+func TestFilterDatastores(t *testing.T) {
+	datastores := []dbaas.Datastore{
+		{ID: "1", Name: "pg", TypeID: "pg-type", Status: dbaas.StatusActive},
+		{ID: "2", Name: "pg", TypeID: "mysql-type", Status: dbaas.StatusActive},
+		{ID: "3", Name: "redis", TypeID: "redis-type", Status: dbaas.StatusPendingCreate},
+	}
+
+	assert.Len(t, filterDatastores(datastores, datastoreSearchFilter{}), 3)
+	assert.Len(t, filterDatastores(datastores, datastoreSearchFilter{name: "pg"}), 2)
+
+	filtered := filterDatastores(datastores, datastoreSearchFilter{name: "pg", typeID: "mysql-type"})
+	assert.Len(t, filtered, 1)
+	assert.Equal(t, "2", filtered[0].ID)
+
+	filtered = filterDatastores(datastores, datastoreSearchFilter{status: string(dbaas.StatusPendingCreate)})
+	assert.Len(t, filtered, 1)
+	assert.Equal(t, "3", filtered[0].ID)
+}
+
+func TestFlattenDBaaSDatastores(t *testing.T) {
+	datastores := []dbaas.Datastore{
+		{
+			ID:         "1",
+			Name:       "pg",
+			Connection: map[string]string{"MASTER": "master.pg.example"},
+			Flavor:     dbaas.Flavor{Vcpus: 2, RAM: 4096, Disk: 32, DiskType: dbaas.DiskLocal},
+			Instances:  []dbaas.Instances{{Role: masterRole, FloatingIP: "192.0.2.10"}},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceDBaaSDatastoresV1().Schema, map[string]interface{}{})
+	assert.NoError(t, d.Set("datastores", flattenDBaaSDatastores(datastores)))
+	assert.Equal(t, "master.pg.example", d.Get("datastores.0.connections.MASTER"))
+	assert.Equal(t, 4096, d.Get("datastores.0.flavor.0.ram"))
+	assert.Equal(t, "192.0.2.10", d.Get("datastores.0.instances.0.floating_ip"))
+}
+
+func testAccDBaaSDatastoresV1Basic(projectName, datastoreName string, nodeCount int) string {
+	return fmt.Sprintf(`
+resource "selectel_vpc_project_v2" "project_tf_acc_test_1" {
+  name        = "%s"
+}
+
+resource "selectel_vpc_subnet_v2" "subnet_tf_acc_test_1" {
+  project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+  region     = "ru-3"
+}
+
+data "selectel_dbaas_datastore_type_v1" "dt" {
+  project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+  region = "ru-3"
+  filter {
+    engine = "postgresql"
+    version = "12"
+  }
+}
+
+resource "selectel_dbaas_postgresql_datastore_v1" "datastore_tf_acc_test_1" {
+  name = "%s"
+  project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+  region = "ru-3"
+  type_id = "${data.selectel_dbaas_datastore_type_v1.dt.datastore_types[0].id}"
+  subnet_id = "${selectel_vpc_subnet_v2.subnet_tf_acc_test_1.subnet_id}"
+  node_count = "%d"
+  flavor {
+    vcpus = 2
+    ram = 4096
+    disk = 32
+  }
+}
+
+data "selectel_dbaas_datastores_v1" "datastores_tf_acc_test_1" {
+  project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+  region = "ru-3"
+  filter {
+    name = "${selectel_dbaas_postgresql_datastore_v1.datastore_tf_acc_test_1.name}"
+    type_id = "${data.selectel_dbaas_datastore_type_v1.dt.datastore_types[0].id}"
+  }
+}`, projectName, datastoreName, nodeCount)
+}
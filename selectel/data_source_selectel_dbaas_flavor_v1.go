@@ -2,9 +2,12 @@ package selectel
 
 import (
 	"context"
+	"errors"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/selectel/dbaas-go"
 )
 
@@ -12,10 +15,15 @@ type flavorSearchFilter struct {
 	vcpus           int
 	ram             int
 	disk            int
+	minVcpus        int
+	minRAM          int
+	minDisk         int
 	flSize          string
 	datastoreTypeID string
 }
 
+var ErrFlavorNotFound = errors.New("no flavors match the filter")
+
 func dataSourceDBaaSFlavorV1() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceDBaaSFlavorV1Read,
@@ -71,6 +79,18 @@ func dataSourceDBaaSFlavorV1() *schema.Resource {
 					},
 				},
 			},
+			"sort_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"vcpus", "ram", "disk",
+				}, false),
+			},
+			"smallest": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"filter": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -88,6 +108,21 @@ func dataSourceDBaaSFlavorV1() *schema.Resource {
 							Type:     schema.TypeInt,
 							Optional: true,
 						},
+						"min_vcpus": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"min_ram": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"min_disk": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
 						"fl_size": {
 							Type:     schema.TypeString,
 							Optional: true,
@@ -127,9 +162,24 @@ func dataSourceDBaaSFlavorV1Read(ctx context.Context, d *schema.ResourceData, me
 	flavors = filterFlavorByVcpus(flavors, filter.vcpus)
 	flavors = filterFlavorByRAM(flavors, filter.ram)
 	flavors = filterFlavorByDisk(flavors, filter.disk)
+	flavors = filterFlavorByMinimums(flavors, filter.minVcpus, filter.minRAM, filter.minDisk)
 	flavors = filterFlavorByFlSize(flavors, filter.flSize)
 	flavors = filterFlavorByDatastoreTypeID(flavors, filter.datastoreTypeID)
 
+	sortBy := d.Get("sort_by").(string)
+	if d.Get("smallest").(bool) {
+		if len(flavors) == 0 {
+			return diag.FromErr(errGettingObjects(objectFlavors, ErrFlavorNotFound))
+		}
+		if sortBy == "" {
+			sortBy = "vcpus"
+		}
+		sortFlavors(flavors, sortBy)
+		flavors = flavors[:1]
+	} else if sortBy != "" {
+		sortFlavors(flavors, sortBy)
+	}
+
 	flavorsFlatten := flattenDBaaSFlavors(flavors)
 	if err := d.Set("flavors", flavorsFlatten); err != nil {
 		return diag.FromErr(err)
@@ -171,6 +221,21 @@ func expandFlavorSearchFilter(filterSet *schema.Set) (flavorSearchFilter, error)
 		filter.flSize = flSize.(string)
 	}
 
+	minVcpus, ok := resourceFilterMap["min_vcpus"]
+	if ok {
+		filter.minVcpus = minVcpus.(int)
+	}
+
+	minRAM, ok := resourceFilterMap["min_ram"]
+	if ok {
+		filter.minRAM = minRAM.(int)
+	}
+
+	minDisk, ok := resourceFilterMap["min_disk"]
+	if ok {
+		filter.minDisk = minDisk.(int)
+	}
+
 	datastoreTypeID, ok := resourceFilterMap["datastore_type_id"]
 	if ok {
 		filter.datastoreTypeID = datastoreTypeID.(string)
@@ -224,6 +289,21 @@ func filterFlavorByDisk(flavors []dbaas.FlavorResponse, disk int) []dbaas.Flavor
 	return filteredFlavors
 }
 
+func filterFlavorByMinimums(flavors []dbaas.FlavorResponse, minVcpus, minRAM, minDisk int) []dbaas.FlavorResponse {
+	if minVcpus == 0 && minRAM == 0 && minDisk == 0 {
+		return flavors
+	}
+
+	var filteredFlavors []dbaas.FlavorResponse
+	for _, f := range flavors {
+		if f.Vcpus >= minVcpus && f.RAM >= minRAM && f.Disk >= minDisk {
+			filteredFlavors = append(filteredFlavors, f)
+		}
+	}
+
+	return filteredFlavors
+}
+
 func filterFlavorByFlSize(flavors []dbaas.FlavorResponse, flSize string) []dbaas.FlavorResponse {
 	if flSize == "" {
 		return flavors
@@ -256,6 +336,35 @@ func filterFlavorByDatastoreTypeID(flavors []dbaas.FlavorResponse, datastoreType
 	return filteredFlavors
 }
 
+// sortFlavors sorts flavors in ascending order by the given attribute. Ties are
+// broken by vCPUs, RAM, disk and ID so the result doesn't depend on the API order.
+func sortFlavors(flavors []dbaas.FlavorResponse, sortBy string) {
+	sort.SliceStable(flavors, func(i, j int) bool {
+		a, b := flavors[i], flavors[j]
+		switch sortBy {
+		case "ram":
+			if a.RAM != b.RAM {
+				return a.RAM < b.RAM
+			}
+		case "disk":
+			if a.Disk != b.Disk {
+				return a.Disk < b.Disk
+			}
+		}
+		if a.Vcpus != b.Vcpus {
+			return a.Vcpus < b.Vcpus
+		}
+		if a.RAM != b.RAM {
+			return a.RAM < b.RAM
+		}
+		if a.Disk != b.Disk {
+			return a.Disk < b.Disk
+		}
+
+		return a.ID < b.ID
+	})
+}
+
 func flattenDBaaSFlavors(flavors []dbaas.FlavorResponse) []interface{} {
 	flavorsList := make([]interface{}, len(flavors))
 	for i, flavor := range flavors {
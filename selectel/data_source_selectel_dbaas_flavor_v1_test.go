@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/selectel/dbaas-go"
 	"github.com/selectel/go-selvpcclient/v4/selvpcclient/resell/v2/projects"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccDBaaSFlavorsV1Basic(t *testing.T) {
@@ -51,6 +52,14 @@ func TestAccDBaaSFlavorsV1Basic(t *testing.T) {
 					resource.TestCheckResourceAttr("data.selectel_dbaas_flavor_v1.flavor_tf_acc_test_1", "flavors.0.datastore_type_ids.#", "1"),
 				),
 			},
+			{
+				Config: testAccDBaaSFlavorsV1SmallestFlavor(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCV2ProjectExists("selectel_vpc_project_v2.project_tf_acc_test_1", &project),
+					resource.TestCheckResourceAttr("data.selectel_dbaas_flavor_v1.flavor_tf_acc_test_1", "flavors.#", "1"),
+					resource.TestCheckResourceAttrSet("data.selectel_dbaas_flavor_v1.flavor_tf_acc_test_1", "flavors.0.id"),
+				),
+			},
 		},
 	})
 }
@@ -116,3 +125,79 @@ data "selectel_dbaas_flavor_v1" "flavor_tf_acc_test_1" {
 }
 `, projectName)
 }
+
+func testAccDBaaSFlavorsV1SmallestFlavor(projectName string) string {
+	return fmt.Sprintf(`
+resource "selectel_vpc_project_v2" "project_tf_acc_test_1" {
+  name        = "%s"
+}
+
+data "selectel_dbaas_datastore_type_v1" "dt" {
+  project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+  region     = "ru-3"
+  filter {
+    engine  = "postgresql"
+    version = "16"
+  }
+}
+
+data "selectel_dbaas_flavor_v1" "flavor_tf_acc_test_1" {
+  project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+  region     = "ru-3"
+  smallest   = true
+  filter {
+    min_vcpus         = 4
+    min_ram           = 16384
+    datastore_type_id = "${data.selectel_dbaas_datastore_type_v1.dt.datastore_types[0].id}"
+  }
+}
+`, projectName)
+}
+
+func TestFilterFlavorByMinimums(t *testing.T) {
+	flavors := []dbaas.FlavorResponse{
+		{ID: "small", Vcpus: 2, RAM: 4096, Disk: 32},
+		{ID: "medium", Vcpus: 4, RAM: 16384, Disk: 64},
+		{ID: "large", Vcpus: 8, RAM: 32768, Disk: 128},
+	}
+
+	assert.Equal(t, flavors, filterFlavorByMinimums(flavors, 0, 0, 0))
+
+	filtered := filterFlavorByMinimums(flavors, 4, 16384, 0)
+	assert.Len(t, filtered, 2)
+	assert.Equal(t, "medium", filtered[0].ID)
+	assert.Equal(t, "large", filtered[1].ID)
+
+	filtered = filterFlavorByMinimums(flavors, 0, 0, 100)
+	assert.Len(t, filtered, 1)
+	assert.Equal(t, "large", filtered[0].ID)
+
+	assert.Empty(t, filterFlavorByMinimums(flavors, 16, 0, 0))
+}
+
+func TestSortFlavors(t *testing.T) {
+	flavors := []dbaas.FlavorResponse{
+		{ID: "c", Vcpus: 4, RAM: 8192, Disk: 32},
+		{ID: "a", Vcpus: 2, RAM: 16384, Disk: 64},
+		{ID: "b", Vcpus: 2, RAM: 8192, Disk: 128},
+		{ID: "d", Vcpus: 2, RAM: 8192, Disk: 64},
+	}
+
+	sortFlavors(flavors, "vcpus")
+	assert.Equal(t, []string{"d", "b", "a", "c"}, flavorIDs(flavors))
+
+	sortFlavors(flavors, "ram")
+	assert.Equal(t, []string{"d", "b", "c", "a"}, flavorIDs(flavors))
+
+	sortFlavors(flavors, "disk")
+	assert.Equal(t, []string{"c", "d", "a", "b"}, flavorIDs(flavors))
+}
+
+func flavorIDs(flavors []dbaas.FlavorResponse) []string {
+	ids := make([]string, len(flavors))
+	for i, flavor := range flavors {
+		ids[i] = flavor.ID
+	}
+
+	return ids
+}
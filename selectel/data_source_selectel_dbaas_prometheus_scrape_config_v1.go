@@ -0,0 +1,200 @@
+package selectel
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/selectel/dbaas-go"
+)
+
+const (
+	dbaasDefaultMetricsJobName = "selectel-dbaas"
+	dbaasDefaultMetricsPath    = "/metrics"
+)
+
+func dataSourceDBaaSPrometheusScrapeConfigV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDBaaSPrometheusScrapeConfigV1Read,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"job_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  dbaasDefaultMetricsJobName,
+			},
+			"metrics_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  dbaasDefaultMetricsPath,
+			},
+			"metrics_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"metric_endpoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"datastore_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"datastore_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"scrape_config": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceDBaaSPrometheusScrapeConfigV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	projectID := d.Get("project_id").(string)
+	region := d.Get("region").(string)
+
+	dbaasClient, diagErr := getDBaaSClient(d, meta)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	selvpcClient, err := config.GetSelVPCClientWithProjectScope(projectID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("can't get project-scope selvpc client for dbaas: %w", err))
+	}
+	endpoint, err := selvpcClient.Catalog.GetEndpoint(DBaaS, region)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("can't get endpoint for dbaas metrics: %w", err))
+	}
+	metricsURL, err := getDBaaSMetricsURL(endpoint.URL, d.Get("metrics_path").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Print(msgGet(objectDatastores, projectID))
+	datastores, err := dbaasClient.Datastores(ctx, &dbaas.DatastoreQueryParams{ProjectID: projectID})
+	if err != nil {
+		return diag.FromErr(errGettingObjects(objectDatastores, err))
+	}
+
+	scrapeConfig, err := renderDBaaSPrometheusScrapeConfig(d.Get("job_name").(string), d.Get("token").(string), metricsURL)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	endpoints := buildDBaaSMetricEndpoints(metricsURL, datastores)
+
+	checksumParts := []string{projectID, region}
+	metricEndpoints := make([]interface{}, len(endpoints))
+	for i, e := range endpoints {
+		checksumParts = append(checksumParts, e.datastoreID)
+		metricEndpoints[i] = map[string]interface{}{
+			"datastore_id":   e.datastoreID,
+			"datastore_name": e.datastoreName,
+			"url":            e.url,
+		}
+	}
+
+	d.Set("metrics_url", metricsURL)
+	if err := d.Set("metric_endpoints", metricEndpoints); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("scrape_config", scrapeConfig)
+
+	checksum, err := stringListChecksum(checksumParts)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(checksum)
+
+	return nil
+}
+
+type dbaasMetricEndpoint struct {
+	datastoreID   string
+	datastoreName string
+	url           string
+}
+
+// https://ru-3.dbaas.selcloud.ru/v1 -> https://ru-3.dbaas.selcloud.ru/metrics
+func getDBaaSMetricsURL(endpoint, metricsPath string) (string, error) {
+	parsedEndpoint, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("can't parse url for dbaas endpoint: %w", err)
+	}
+
+	return fmt.Sprintf("%s://%s/%s", parsedEndpoint.Scheme, parsedEndpoint.Host, strings.TrimLeft(metricsPath, "/")), nil
+}
+
+// buildDBaaSMetricEndpoints lists the datastores exported by the metrics endpoint.
+// The endpoint serves metrics of all datastores of the project in the pool, so
+// every datastore has the same URL.
+func buildDBaaSMetricEndpoints(metricsURL string, datastores []dbaas.Datastore) []dbaasMetricEndpoint {
+	endpoints := make([]dbaasMetricEndpoint, 0, len(datastores))
+	for _, datastore := range datastores {
+		endpoints = append(endpoints, dbaasMetricEndpoint{
+			datastoreID:   datastore.ID,
+			datastoreName: datastore.Name,
+			url:           metricsURL,
+		})
+	}
+	sort.Slice(endpoints, func(i, j int) bool {
+		return endpoints[i].datastoreID < endpoints[j].datastoreID
+	})
+
+	return endpoints
+}
+
+// renderDBaaSPrometheusScrapeConfig renders a scrape_configs YAML section with a
+// single job for the metrics endpoint of the pool. Values are double-quoted so
+// any job name is valid YAML.
+func renderDBaaSPrometheusScrapeConfig(jobName, token, metricsURL string) (string, error) {
+	parsedURL, err := url.Parse(metricsURL)
+	if err != nil {
+		return "", fmt.Errorf("can't parse url for dbaas metrics: %w", err)
+	}
+
+	var b strings.Builder
+	b.WriteString("scrape_configs:\n")
+	fmt.Fprintf(&b, "  - job_name: %s\n", strconv.Quote(jobName))
+	fmt.Fprintf(&b, "    scheme: %s\n", strconv.Quote(parsedURL.Scheme))
+	fmt.Fprintf(&b, "    metrics_path: %s\n", strconv.Quote(parsedURL.Path))
+	b.WriteString("    authorization:\n")
+	b.WriteString("      type: \"Bearer\"\n")
+	fmt.Fprintf(&b, "      credentials: %s\n", strconv.Quote(token))
+	b.WriteString("    static_configs:\n")
+	fmt.Fprintf(&b, "      - targets: [%s]\n", strconv.Quote(parsedURL.Host))
+
+	return b.String(), nil
+}
@@ -0,0 +1,181 @@
+package selectel
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/selectel/dbaas-go"
+	"github.com/selectel/go-selvpcclient/v4/selvpcclient/resell/v2/projects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccDBaaSDataSourcePrometheusScrapeConfigV1Basic(t *testing.T) {
+	var project projects.Project
+
+	projectName := acctest.RandomWithPrefix("tf-acc")
+	tokenName := acctest.RandomWithPrefix("tf-acc-token")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSelectelPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckVPCV2ProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDBaaSDataSourcePrometheusScrapeConfigV1Basic(projectName, tokenName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCV2ProjectExists("selectel_vpc_project_v2.project_tf_acc_test_1", &project),
+					resource.TestCheckResourceAttrSet("data.selectel_dbaas_prometheus_scrape_config_v1.scrape_config_tf_acc_test_1", "metrics_url"),
+					resource.TestCheckResourceAttr("data.selectel_dbaas_prometheus_scrape_config_v1.scrape_config_tf_acc_test_1", "metric_endpoints.#", "0"),
+					resource.TestCheckResourceAttrSet("data.selectel_dbaas_prometheus_scrape_config_v1.scrape_config_tf_acc_test_1", "scrape_config"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDBaaSDataSourcePrometheusScrapeConfigV1Basic(projectName, tokenName string) string {
+	return fmt.Sprintf(`
+resource "selectel_vpc_project_v2" "project_tf_acc_test_1" {
+  name        = "%s"
+}
+
+resource "selectel_dbaas_prometheus_metric_token_v1" "prometheus_metric_token_tf_acc_test_1" {
+  project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+  region     = "ru-3"
+  name       = "%s"
+}
+
+data "selectel_dbaas_prometheus_scrape_config_v1" "scrape_config_tf_acc_test_1" {
+  project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+  region     = "ru-3"
+  token      = "${selectel_dbaas_prometheus_metric_token_v1.prometheus_metric_token_tf_acc_test_1.value}"
+}
+`, projectName, tokenName)
+}
+
+func TestAccDBaaSDataSourcePrometheusScrapeConfigV1WithDatastore(t *testing.T) {
+	var project projects.Project
+
+	projectName := acctest.RandomWithPrefix("tf-acc")
+	tokenName := acctest.RandomWithPrefix("tf-acc-token")
+	datastoreName := acctest.RandomWithPrefix("tf-acc-ds")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSelectelPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckVPCV2ProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDBaaSDataSourcePrometheusScrapeConfigV1WithDatastore(projectName, tokenName, datastoreName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCV2ProjectExists("selectel_vpc_project_v2.project_tf_acc_test_1", &project),
+					resource.TestCheckResourceAttr("data.selectel_dbaas_prometheus_scrape_config_v1.scrape_config_tf_acc_test_1", "metric_endpoints.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.selectel_dbaas_prometheus_scrape_config_v1.scrape_config_tf_acc_test_1", "metric_endpoints.0.datastore_id",
+						"selectel_dbaas_postgresql_datastore_v1.datastore_tf_acc_test_1", "id",
+					),
+					resource.TestCheckResourceAttr("data.selectel_dbaas_prometheus_scrape_config_v1.scrape_config_tf_acc_test_1", "metric_endpoints.0.datastore_name", datastoreName),
+					resource.TestCheckResourceAttrPair(
+						"data.selectel_dbaas_prometheus_scrape_config_v1.scrape_config_tf_acc_test_1", "metric_endpoints.0.url",
+						"data.selectel_dbaas_prometheus_scrape_config_v1.scrape_config_tf_acc_test_1", "metrics_url",
+					),
+					resource.TestCheckResourceAttrSet("data.selectel_dbaas_prometheus_scrape_config_v1.scrape_config_tf_acc_test_1", "scrape_config"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDBaaSDataSourcePrometheusScrapeConfigV1WithDatastore(projectName, tokenName, datastoreName string) string {
+	return fmt.Sprintf(`
+resource "selectel_vpc_project_v2" "project_tf_acc_test_1" {
+  name        = "%s"
+}
+
+resource "selectel_vpc_subnet_v2" "subnet_tf_acc_test_1" {
+  project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+  region     = "ru-3"
+}
+
+data "selectel_dbaas_datastore_type_v1" "dt" {
+  project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+  region = "ru-3"
+  filter {
+    engine = "postgresql"
+    version = "12"
+  }
+}
+
+resource "selectel_dbaas_postgresql_datastore_v1" "datastore_tf_acc_test_1" {
+  name = "%s"
+  project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+  region = "ru-3"
+  type_id = "${data.selectel_dbaas_datastore_type_v1.dt.datastore_types[0].id}"
+  subnet_id = "${selectel_vpc_subnet_v2.subnet_tf_acc_test_1.subnet_id}"
+  node_count = 1
+  flavor {
+    vcpus = 2
+    ram = 4096
+    disk = 32
+  }
+}
+
+resource "selectel_dbaas_prometheus_metric_token_v1" "prometheus_metric_token_tf_acc_test_1" {
+  project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+  region     = "ru-3"
+  name       = "%s"
+}
+
+data "selectel_dbaas_prometheus_scrape_config_v1" "scrape_config_tf_acc_test_1" {
+  project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+  region     = "ru-3"
+  token      = "${selectel_dbaas_prometheus_metric_token_v1.prometheus_metric_token_tf_acc_test_1.value}"
+
+  depends_on = [selectel_dbaas_postgresql_datastore_v1.datastore_tf_acc_test_1]
+}
+`, projectName, datastoreName, tokenName)
+}
+
+func TestGetDBaaSMetricsURL(t *testing.T) {
+	metricsURL, err := getDBaaSMetricsURL("https://ru-3.dbaas.selcloud.ru/v1", "/metrics")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://ru-3.dbaas.selcloud.ru/metrics", metricsURL)
+
+	metricsURL, err = getDBaaSMetricsURL("https://ru-3.dbaas.selcloud.ru", "custom/metrics")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://ru-3.dbaas.selcloud.ru/custom/metrics", metricsURL)
+}
+
+func TestBuildDBaaSMetricEndpoints(t *testing.T) {
+	endpoints := buildDBaaSMetricEndpoints("https://ru-3.dbaas.selcloud.ru/metrics", []dbaas.Datastore{
+		{ID: "id-2", Name: "kafka"},
+		{ID: "id-1", Name: "pg"},
+	})
+
+	assert.Equal(t, []dbaasMetricEndpoint{
+		{datastoreID: "id-1", datastoreName: "pg", url: "https://ru-3.dbaas.selcloud.ru/metrics"},
+		{datastoreID: "id-2", datastoreName: "kafka", url: "https://ru-3.dbaas.selcloud.ru/metrics"},
+	}, endpoints)
+	assert.Empty(t, buildDBaaSMetricEndpoints("https://ru-3.dbaas.selcloud.ru/metrics", nil))
+}
+
+func TestRenderDBaaSPrometheusScrapeConfig(t *testing.T) {
+	expected := `scrape_configs:
+  - job_name: "selectel-dbaas \"main\""
+    scheme: "https"
+    metrics_path: "/metrics"
+    authorization:
+      type: "Bearer"
+      credentials: "secret"
+    static_configs:
+      - targets: ["ru-3.dbaas.selcloud.ru"]
+`
+	scrapeConfig, err := renderDBaaSPrometheusScrapeConfig(`selectel-dbaas "main"`, "secret", "https://ru-3.dbaas.selcloud.ru/metrics")
+	assert.NoError(t, err)
+	assert.Equal(t, expected, scrapeConfig)
+
+	_, err = renderDBaaSPrometheusScrapeConfig("selectel-dbaas", "secret", "https://ru-3.dbaas.selcloud.ru/%zz")
+	assert.Error(t, err)
+}
@@ -0,0 +1,92 @@
+package selectel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDBaaSUsersV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDBaaSUsersV1Read,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"datastore_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"datastore_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDBaaSUsersV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dbaasClient, diagErr := getDBaaSClient(d, meta)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	users, err := dbaasClient.Users(ctx)
+	if err != nil {
+		return diag.FromErr(errGettingObjects(objectUsers, err))
+	}
+
+	// The users API has no query parameters, so filter by datastore on our side.
+	datastoreID := d.Get("datastore_id").(string)
+	userIDs := []string{}
+	usersList := []interface{}{}
+	for _, user := range users {
+		if datastoreID != "" && user.DatastoreID != datastoreID {
+			continue
+		}
+		userIDs = append(userIDs, user.ID)
+		usersList = append(usersList, map[string]interface{}{
+			"id":           user.ID,
+			"name":         user.Name,
+			"datastore_id": user.DatastoreID,
+			"status":       string(user.Status),
+		})
+	}
+
+	if err := d.Set("users", usersList); err != nil {
+		return diag.FromErr(err)
+	}
+	checksum, err := stringListChecksum(userIDs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(checksum)
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package selectel
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/selectel/dbaas-go"
+	"github.com/selectel/go-selvpcclient/v4/selvpcclient/resell/v2/projects"
+)
+
+func TestAccDBaaSUsersV1Basic(t *testing.T) {
+	var project projects.Project
+
+	projectName := acctest.RandomWithPrefix("tf-acc")
+	datastoreName := acctest.RandomWithPrefix("tf-acc-ds")
+	userName := RandomWithPrefix("tf_acc_user")
+	userPassword := acctest.RandomWithPrefix("tf-acc-pass")
+	databaseName := RandomWithPrefix("tf_acc_db")
+	nodeCount := 1
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSelectelPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckVPCV2ProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDBaaSDatabasesAndUsersV1Basic(projectName, datastoreName, userName, userPassword, databaseName, nodeCount),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCV2ProjectExists("selectel_vpc_project_v2.project_tf_acc_test_1", &project),
+					resource.TestCheckResourceAttr("data.selectel_dbaas_users_v1.users_tf_acc_test_1", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.selectel_dbaas_users_v1.users_tf_acc_test_1", "users.0.name", userName),
+					resource.TestCheckResourceAttr("data.selectel_dbaas_users_v1.users_tf_acc_test_1", "users.0.status", string(dbaas.StatusActive)),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,198 @@
+package selectel
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/selectel/domains-go/pkg/v1/domain"
+	"github.com/selectel/domains-go/pkg/v1/record"
+	domainsV2 "github.com/selectel/domains-go/pkg/v2"
+)
+
+func dataSourceDomainsDomainRRSetsV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDomainsDomainRRSetsV1Read,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"include_apex_ns": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"rrsets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"records": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"record_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"zone_file": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDomainsDomainRRSetsV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getDomainsClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	domainName := d.Get("name").(string)
+
+	log.Print(msgGet(objectDomain, domainName))
+
+	domainObj, _, err := domain.GetByName(ctx, client, domainName)
+	if err != nil {
+		return diag.FromErr(errGettingObject(objectDomain, domainName, err))
+	}
+
+	log.Print(msgGet(objectRecord, domainName))
+
+	records, _, err := record.ListByDomainID(ctx, client, domainObj.ID)
+	if err != nil {
+		return diag.FromErr(errGettingObjects(objectRecord, err))
+	}
+
+	rrsets, recordIDs, err := groupDomainsV1RecordsToRRSets(domainObj.ID, domainObj.Name, records, d.Get("include_apex_ns").(bool))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flattenedRRSets := flattenZoneFileRRSets(rrsets)
+	renderedRRSets := make([]*domainsV2.RRSet, len(rrsets))
+	for i, rrset := range rrsets {
+		flattenedRRSets[i].(map[string]interface{})["record_ids"] = recordIDs[rrset.key()]
+		v2RRSet := rrsetFromZoneFileRRSet(rrset, "", nil)
+		renderedRRSets[i] = &v2RRSet
+	}
+
+	d.SetId(strconv.Itoa(domainObj.ID))
+	if err := d.Set("rrsets", flattenedRRSets); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("zone_file", renderZoneFile(domainObj.Name, zoneRecordsDefaultTTL, renderedRRSets))
+
+	return nil
+}
+
+// groupDomainsV1RecordsToRRSets groups Domains v1 records into RRSets in the format of
+// DNS Hosting (actual). SOA records are skipped, as well as NS records of the domain
+// unless includeApexNS is set. An RRSet gets the smallest TTL of its records. Record
+// IDs are returned in the <domain_id>/<record_id> format used by
+// selectel_domains_record_v1, grouped by RRSet key.
+func groupDomainsV1RecordsToRRSets(domainID int, domainName string, records []*record.View, includeApexNS bool) ([]zoneFileRRSet, map[string][]interface{}, error) {
+	apex := fqdn(domainName)
+	rrsets := map[string]*zoneFileRRSet{}
+	recordIDs := map[string][]interface{}{}
+	for _, r := range records {
+		name := fqdn(r.Name)
+		if r.Type == record.TypeSOA || (r.Type == record.TypeNS && name == apex && !includeApexNS) {
+			continue
+		}
+
+		content, err := convertDomainsV1RecordContent(r)
+		if err != nil {
+			return nil, nil, fmt.Errorf("record %d: %w", r.ID, err)
+		}
+
+		key := rrsetKey(name, string(r.Type))
+		rrset, ok := rrsets[key]
+		if !ok {
+			rrset = &zoneFileRRSet{name: name, recordType: string(r.Type), ttl: r.TTL}
+			rrsets[key] = rrset
+		}
+		if r.TTL < rrset.ttl {
+			rrset.ttl = r.TTL
+		}
+		if !containsString(rrset.records, content) {
+			rrset.records = append(rrset.records, content)
+		}
+		recordIDs[key] = append(recordIDs[key], fmt.Sprintf("%d/%d", domainID, r.ID))
+	}
+
+	result := make([]zoneFileRRSet, 0, len(rrsets))
+	for _, rrset := range rrsets {
+		sort.Strings(rrset.records)
+		result = append(result, *rrset)
+	}
+	sortZoneFileRRSets(result)
+
+	return result, recordIDs, nil
+}
+
+// convertDomainsV1RecordContent converts fields of a Domains v1 record to the record
+// content of DNS Hosting (actual). Domain names get a trailing dot.
+func convertDomainsV1RecordContent(r *record.View) (string, error) {
+	switch r.Type {
+	case record.TypeA, record.TypeAAAA:
+		return r.Content, nil
+	case record.TypeCNAME, record.TypeNS, record.TypeALIAS:
+		return fqdn(r.Content), nil
+	case record.TypeTXT:
+		return expandTXTContent(r.Content), nil
+	case record.TypeMX:
+		if r.Priority == nil {
+			return "", fmt.Errorf("MX record %s has no priority", r.Name)
+		}
+
+		return fmt.Sprintf("%d %s", *r.Priority, fqdn(r.Content)), nil
+	case record.TypeSRV:
+		if r.Priority == nil || r.Weight == nil || r.Port == nil {
+			return "", fmt.Errorf("SRV record %s must have priority, weight and port", r.Name)
+		}
+
+		return fmt.Sprintf("%d %d %d %s", *r.Priority, *r.Weight, *r.Port, fqdn(r.Target)), nil
+	case record.TypeCAA:
+		if r.Flag == nil {
+			return "", fmt.Errorf("CAA record %s has no flag", r.Name)
+		}
+
+		return fmt.Sprintf("%d %s %s", *r.Flag, r.Tag, formatTXTContent(strings.Trim(r.Value, `"`))), nil
+	case record.TypeSSHFP:
+		if r.Algorithm == nil || r.FingerprintType == nil {
+			return "", fmt.Errorf("SSHFP record %s must have algorithm and fingerprint type", r.Name)
+		}
+
+		return fmt.Sprintf("%d %d %s", *r.Algorithm, *r.FingerprintType, r.Fingerprint), nil
+	default:
+		return "", fmt.Errorf("record type %s is not supported", r.Type)
+	}
+}
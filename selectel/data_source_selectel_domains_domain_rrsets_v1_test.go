@@ -0,0 +1,101 @@
+package selectel
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/selectel/domains-go/pkg/v1/record"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccDomainsDomainRRSetsV1DataSourceBasic(t *testing.T) {
+	testDomainName := fmt.Sprintf("%s.xyz", acctest.RandomWithPrefix("tf-acc"))
+	dataSourceName := "data.selectel_domains_domain_rrsets_v1.domain_rrsets_tf_acc_test_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSelectelPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDomainsV1DomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainsDomainRRSetsV1DataSourceBasic(testDomainName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "rrsets.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "rrsets.0.name", fmt.Sprintf("a.%s.", testDomainName)),
+					resource.TestCheckResourceAttr(dataSourceName, "rrsets.0.type", "A"),
+					resource.TestCheckResourceAttr(dataSourceName, "rrsets.0.records.0", "127.0.0.1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rrsets.0.record_ids.0", "selectel_domains_record_v1.record_a_tf_acc_test_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDomainsDomainRRSetsV1DataSourceBasic(domainName string) string {
+	return fmt.Sprintf(`
+resource "selectel_domains_domain_v1" "domain_tf_acc_test_1" {
+  name = "%[1]s"
+}
+
+resource "selectel_domains_record_v1" "record_a_tf_acc_test_1" {
+  domain_id = selectel_domains_domain_v1.domain_tf_acc_test_1.id
+  name      = "a.%[1]s"
+  type      = "A"
+  content   = "127.0.0.1"
+  ttl       = 60
+}
+
+data "selectel_domains_domain_rrsets_v1" "domain_rrsets_tf_acc_test_1" {
+  name = selectel_domains_domain_v1.domain_tf_acc_test_1.name
+
+  depends_on = [selectel_domains_record_v1.record_a_tf_acc_test_1]
+}
+`, domainName)
+}
+
+func TestGroupDomainsV1RecordsToRRSets(t *testing.T) {
+	records := []*record.View{
+		{ID: 1, Name: "example.com", Type: record.TypeSOA, TTL: 3600, Content: "ns1.selectel.org"},
+		{ID: 2, Name: "example.com", Type: record.TypeNS, TTL: 86400, Content: "ns1.selectel.org"},
+		{ID: 3, Name: "example.com", Type: record.TypeA, TTL: 300, Content: "192.0.2.2"},
+		{ID: 4, Name: "example.com", Type: record.TypeA, TTL: 60, Content: "192.0.2.1"},
+		{ID: 5, Name: "example.com", Type: record.TypeMX, TTL: 300, Content: "mail.example.com", Priority: intPtr(10)},
+		{ID: 6, Name: "_sip._tcp.example.com", Type: record.TypeSRV, TTL: 300, Priority: intPtr(10), Weight: intPtr(20), Port: intPtr(5060), Target: "sip.example.com"},
+		{ID: 7, Name: "example.com", Type: record.TypeCAA, TTL: 300, Flag: intPtr(0), Tag: "issue", Value: "letsencrypt.org"},
+		{ID: 8, Name: "example.com", Type: record.TypeSSHFP, TTL: 300, Algorithm: intPtr(1), FingerprintType: intPtr(1), Fingerprint: "abc123"},
+		{ID: 9, Name: "txt.example.com", Type: record.TypeTXT, TTL: 300, Content: "v=spf1 -all"},
+		{ID: 10, Name: "www.example.com", Type: record.TypeCNAME, TTL: 300, Content: "example.com"},
+	}
+
+	rrsets, recordIDs, err := groupDomainsV1RecordsToRRSets(42, "example.com", records, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []zoneFileRRSet{
+		{name: "_sip._tcp.example.com.", recordType: "SRV", ttl: 300, records: []string{"10 20 5060 sip.example.com."}},
+		{name: "example.com.", recordType: "A", ttl: 60, records: []string{"192.0.2.1", "192.0.2.2"}},
+		{name: "example.com.", recordType: "CAA", ttl: 300, records: []string{`0 issue "letsencrypt.org"`}},
+		{name: "example.com.", recordType: "MX", ttl: 300, records: []string{"10 mail.example.com."}},
+		{name: "example.com.", recordType: "SSHFP", ttl: 300, records: []string{"1 1 abc123"}},
+		{name: "txt.example.com.", recordType: "TXT", ttl: 300, records: []string{`"v=spf1 -all"`}},
+		{name: "www.example.com.", recordType: "CNAME", ttl: 300, records: []string{"example.com."}},
+	}, rrsets)
+	assert.Equal(t, []interface{}{"42/3", "42/4"}, recordIDs["example.com./A"])
+	assert.NotContains(t, recordIDs, "example.com./NS")
+
+	for _, rrset := range rrsets {
+		assert.NoError(t, validateRRSetV2Contents(rrset.recordType, rrset.records))
+	}
+
+	rrsets, _, err = groupDomainsV1RecordsToRRSets(42, "example.com", records[:2], true)
+	assert.NoError(t, err)
+	assert.Equal(t, []zoneFileRRSet{
+		{name: "example.com.", recordType: "NS", ttl: 86400, records: []string{"ns1.selectel.org."}},
+	}, rrsets)
+
+	_, _, err = groupDomainsV1RecordsToRRSets(42, "example.com", []*record.View{
+		{ID: 11, Name: "example.com", Type: record.TypeMX, Content: "mail.example.com"},
+	}, false)
+	assert.EqualError(t, err, "record 11: MX record example.com has no priority")
+}
@@ -7,19 +7,31 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	domainsV2 "github.com/selectel/domains-go/pkg/v2"
 )
 
 func dataSourceDomainsRRSetV2() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceDomainsRRSetV2Read,
 		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
 			"name": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				RequiredWith: []string{"type"},
 			},
 			"type": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"name"},
 			},
 			"zone_id": {
 				Type:     schema.TypeString,
@@ -67,16 +79,28 @@ func dataSourceDomainsRRSetV2Read(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	rrsetName := d.Get("name").(string)
 	zoneID := d.Get("zone_id").(string)
-	rrsetType := d.Get("type").(string)
 
-	zoneIDWithRRSetNameAndType := fmt.Sprintf("zone_id: %s, rrset_name: %s, rrset_type: %s", zoneID, rrsetName, rrsetType)
-	log.Println(msgGet(objectRRSet, zoneIDWithRRSetNameAndType))
+	var rrset *domainsV2.RRSet
+	if rrsetID, ok := d.GetOk("id"); ok {
+		zoneIDWithRRSetID := fmt.Sprintf("zone_id: %s, rrset_id: %s", zoneID, rrsetID)
+		log.Println(msgGet(objectRRSet, zoneIDWithRRSetID))
 
-	rrset, err := getRRSetByNameAndType(ctx, client, zoneID, rrsetName, rrsetType)
-	if err != nil {
-		return diag.FromErr(err)
+		rrset, err = client.GetRRSet(ctx, zoneID, rrsetID.(string))
+		if err != nil {
+			return diag.FromErr(errGettingObject(objectRRSet, zoneIDWithRRSetID, err))
+		}
+	} else {
+		rrsetName := d.Get("name").(string)
+		rrsetType := d.Get("type").(string)
+
+		zoneIDWithRRSetNameAndType := fmt.Sprintf("zone_id: %s, rrset_name: %s, rrset_type: %s", zoneID, rrsetName, rrsetType)
+		log.Println(msgGet(objectRRSet, zoneIDWithRRSetNameAndType))
+
+		rrset, err = getRRSetByNameAndType(ctx, client, zoneID, rrsetName, rrsetType)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	err = setRRSetToResourceData(d, rrset)
@@ -46,3 +46,36 @@ func testAccDomainsRRSetV2DataSourceBasic(projectName, resourceRRSetName, rrsetN
 	}
 `, testAccDomainsRRSetV2WithZoneBasic(projectName, resourceRRSetName, rrsetName, rrsetType, rrsetContent, ttl, resourceZoneName, zoneName), resourceRRSetName, resourceZoneName)
 }
+
+func TestAccDomainsRRSetV2DataSourceByID(t *testing.T) {
+	testProjectName := acctest.RandomWithPrefix("tf-acc")
+	testZoneName := fmt.Sprintf("%s.ru.", acctest.RandomWithPrefix("tf-acc"))
+	testRRSetName := fmt.Sprintf("%[1]s.%[2]s", acctest.RandomWithPrefix("tf-acc"), testZoneName)
+	dataSourceRRSetName := fmt.Sprintf("data.selectel_domains_rrset_v2.%[1]s", resourceRRSetName)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSelectelPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDomainsV2RRSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainsRRSetV2DataSourceByID(testProjectName, resourceRRSetName, testRRSetName, resourceZoneName, testZoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceRRSetName, "id", fmt.Sprintf("selectel_domains_rrset_v2.%[1]s", resourceRRSetName), "id"),
+					resource.TestCheckResourceAttr(dataSourceRRSetName, "name", testRRSetName),
+					resource.TestCheckResourceAttr(dataSourceRRSetName, "type", "A"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDomainsRRSetV2DataSourceByID(projectName, resourceRRSetName, rrsetName, resourceZoneName, zoneName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+	data "selectel_domains_rrset_v2" %[2]q {
+	  id = selectel_domains_rrset_v2.%[2]s.id
+	  zone_id = selectel_domains_zone_v2.%[3]s.id
+	  project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+	}
+`, testAccDomainsRRSetV2WithZoneBasic(projectName, resourceRRSetName, rrsetName, "A", "127.0.0.1", 60, resourceZoneName, zoneName), resourceRRSetName, resourceZoneName)
+}
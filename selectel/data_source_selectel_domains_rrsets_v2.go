@@ -0,0 +1,198 @@
+package selectel
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	domainsV2 "github.com/selectel/domains-go/pkg/v2"
+)
+
+type rrsetSearchFilter struct {
+	nameSuffix string
+	rrsetType  string
+	managedBy  string
+}
+
+func dataSourceDomainsRRSetsV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDomainsRRSetsV2Read,
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name_suffix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"managed_by": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"rrsets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"comment": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"managed_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"records": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"content": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"disabled": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDomainsRRSetsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getDomainsV2Client(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	filter := expandRRSetSearchFilter(d.Get("filter").(*schema.Set))
+
+	log.Println(msgGet(objectRRSet, fmt.Sprintf("zone_id: %s", zoneID)))
+
+	rrsets, err := listRRSetsByType(ctx, client, zoneID, filter.rrsetType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rrsets = filterRRSets(rrsets, filter)
+
+	rrsetIDs := []string{zoneID}
+	for _, rrset := range rrsets {
+		rrsetIDs = append(rrsetIDs, rrset.ID)
+	}
+
+	if err := d.Set("rrsets", flattenDomainsRRSetsV2(rrsets)); err != nil {
+		return diag.FromErr(err)
+	}
+	checksum, err := stringListChecksum(rrsetIDs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(checksum)
+
+	return nil
+}
+
+func expandRRSetSearchFilter(filterSet *schema.Set) rrsetSearchFilter {
+	filter := rrsetSearchFilter{}
+	if filterSet.Len() == 0 {
+		return filter
+	}
+
+	resourceFilterMap := filterSet.List()[0].(map[string]interface{})
+
+	if nameSuffix, ok := resourceFilterMap["name_suffix"]; ok {
+		filter.nameSuffix = nameSuffix.(string)
+	}
+	if rrsetType, ok := resourceFilterMap["type"]; ok {
+		filter.rrsetType = rrsetType.(string)
+	}
+	if managedBy, ok := resourceFilterMap["managed_by"]; ok {
+		filter.managedBy = managedBy.(string)
+	}
+
+	return filter
+}
+
+// filterRRSets returns RRSets matching the filter sorted by name and type.
+func filterRRSets(rrsets []*domainsV2.RRSet, filter rrsetSearchFilter) []*domainsV2.RRSet {
+	var filteredRRSets []*domainsV2.RRSet
+	for _, rrset := range rrsets {
+		if filter.nameSuffix != "" && !hasDomainNameSuffix(rrset.Name, filter.nameSuffix) {
+			continue
+		}
+		if filter.rrsetType != "" && string(rrset.Type) != filter.rrsetType {
+			continue
+		}
+		if filter.managedBy != "" && rrset.ManagedBy != filter.managedBy {
+			continue
+		}
+		filteredRRSets = append(filteredRRSets, rrset)
+	}
+	sort.Slice(filteredRRSets, func(i, j int) bool {
+		return rrsetKey(filteredRRSets[i].Name, string(filteredRRSets[i].Type)) <
+			rrsetKey(filteredRRSets[j].Name, string(filteredRRSets[j].Type))
+	})
+
+	return filteredRRSets
+}
+
+func flattenDomainsRRSetsV2(rrsets []*domainsV2.RRSet) []interface{} {
+	result := make([]interface{}, len(rrsets))
+	for i, rrset := range rrsets {
+		result[i] = map[string]interface{}{
+			"id":         rrset.ID,
+			"name":       rrset.Name,
+			"type":       string(rrset.Type),
+			"ttl":        rrset.TTL,
+			"comment":    rrset.Comment,
+			"managed_by": rrset.ManagedBy,
+			"records":    generateSetFromRecords(rrset.Type, rrset.Records, nil),
+		}
+	}
+
+	return result
+}
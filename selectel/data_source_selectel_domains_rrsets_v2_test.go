@@ -0,0 +1,61 @@
+package selectel
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	domainsV2 "github.com/selectel/domains-go/pkg/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccDomainsRRSetsV2DataSourceBasic(t *testing.T) {
+	testProjectName := acctest.RandomWithPrefix("tf-acc")
+	testZoneName := fmt.Sprintf("%s.ru.", acctest.RandomWithPrefix("tf-acc"))
+	testRRSetName := fmt.Sprintf("%[1]s.%[2]s", acctest.RandomWithPrefix("tf-acc"), testZoneName)
+	dataSourceName := fmt.Sprintf("data.selectel_domains_rrsets_v2.%s", resourceRRSetName)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSelectelPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDomainsV2RRSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainsRRSetsV2DataSourceBasic(testProjectName, resourceRRSetName, testRRSetName, resourceZoneName, testZoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "rrsets.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "rrsets.0.name", testRRSetName),
+					resource.TestCheckResourceAttr(dataSourceName, "rrsets.0.records.0.content", "127.0.0.1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDomainsRRSetsV2DataSourceBasic(projectName, resourceRRSetName, rrsetName, resourceZoneName, zoneName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+	data "selectel_domains_rrsets_v2" %[2]q {
+	  zone_id = selectel_domains_zone_v2.%[3]s.id
+	  project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+	  filter {
+	    name_suffix = selectel_domains_rrset_v2.%[2]s.name
+	    type        = "A"
+	  }
+	}
+`, testAccDomainsRRSetV2WithZoneBasic(projectName, resourceRRSetName, rrsetName, "A", "127.0.0.1", 60, resourceZoneName, zoneName), resourceRRSetName, resourceZoneName)
+}
+
+func TestFilterRRSets(t *testing.T) {
+	rrsets := []*domainsV2.RRSet{
+		{ID: "1", Name: "www.example.com.", Type: domainsV2.A},
+		{ID: "2", Name: "example.com.", Type: domainsV2.TXT, ManagedBy: "cert-manager"},
+		{ID: "3", Name: "example.com.", Type: domainsV2.A},
+		{ID: "4", Name: "api.example.com.", Type: domainsV2.TXT},
+	}
+
+	assert.Equal(t, []*domainsV2.RRSet{rrsets[3], rrsets[2], rrsets[1], rrsets[0]}, filterRRSets(rrsets, rrsetSearchFilter{}))
+	assert.Equal(t, []*domainsV2.RRSet{rrsets[2], rrsets[0]}, filterRRSets(rrsets, rrsetSearchFilter{rrsetType: "A"}))
+	assert.Equal(t, []*domainsV2.RRSet{rrsets[1]}, filterRRSets(rrsets, rrsetSearchFilter{managedBy: "cert-manager"}))
+	assert.Equal(t, []*domainsV2.RRSet{rrsets[0]}, filterRRSets(rrsets, rrsetSearchFilter{nameSuffix: "www.example.com"}))
+}
@@ -0,0 +1,69 @@
+package selectel
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceDomainsZoneFileV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDomainsZoneFileV2Read,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"default_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      zoneRecordsDefaultTTL,
+				ValidateFunc: validation.IntBetween(60, 604800),
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"zone_file": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDomainsZoneFileV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getDomainsV2Client(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	zoneName := d.Get("name").(string)
+
+	log.Println(msgGet(objectZone, zoneName))
+
+	zone, err := getZoneByName(ctx, client, zoneName)
+	if err != nil {
+		return diag.FromErr(errGettingObject(objectZone, zoneName, err))
+	}
+
+	log.Println(msgGet(objectRRSet, zone.ID))
+
+	rrsets, err := listAllRRSets(ctx, client, zone.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(zone.ID)
+	d.Set("zone_id", zone.ID)
+	d.Set("zone_file", renderZoneFile(zone.Name, d.Get("default_ttl").(int), rrsets))
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package selectel
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDomainsZoneFileV2DataSourceBasic(t *testing.T) {
+	testProjectName := acctest.RandomWithPrefix("tf-acc")
+	testZoneName := fmt.Sprintf("%s.ru.", acctest.RandomWithPrefix("tf-acc"))
+	dataSourceName := fmt.Sprintf("data.selectel_domains_zone_file_v2.%s", resourceZoneName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSelectelPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDomainsV2ZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainsZoneFileV2DataSourceBasic(testProjectName, resourceZoneName, testZoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "zone_id", fmt.Sprintf("selectel_domains_zone_v2.%s", resourceZoneName), "id"),
+					resource.TestMatchResourceAttr(dataSourceName, "zone_file", regexp.MustCompile(fmt.Sprintf(`^\$ORIGIN %s\n\$TTL 3600\n@\t\d*\tIN\tSOA\t`, regexp.QuoteMeta(testZoneName)))),
+				),
+			},
+		},
+	})
+}
+
+func testAccDomainsZoneFileV2DataSourceBasic(projectName, resourceName, zoneName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+	data "selectel_domains_zone_file_v2" %[2]q {
+	  name = selectel_domains_zone_v2.%[2]s.name
+	  project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+	}
+`, testAccDomainsZoneV2Basic(projectName, resourceName, zoneName), resourceName)
+}
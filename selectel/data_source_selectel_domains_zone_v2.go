@@ -6,15 +6,24 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	domainsV2 "github.com/selectel/domains-go/pkg/v2"
 )
 
 func dataSourceDomainsZoneV2() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceDomainsZoneV2Read,
 		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
 			"name": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
 			},
 			"project_id": {
 				Type:     schema.TypeString,
@@ -48,6 +57,13 @@ func dataSourceDomainsZoneV2() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"name_servers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -58,19 +74,35 @@ func dataSourceDomainsZoneV2Read(ctx context.Context, d *schema.ResourceData, me
 		return diag.FromErr(err)
 	}
 
-	zoneName := d.Get("name").(string)
+	var zone *domainsV2.Zone
+	if zoneID, ok := d.GetOk("id"); ok {
+		log.Println(msgGet(objectZone, zoneID.(string)))
 
-	log.Println(msgGet(objectZone, zoneName))
+		zone, err = client.GetZone(ctx, zoneID.(string), nil)
+		if err != nil {
+			return diag.FromErr(errGettingObject(objectZone, zoneID.(string), err))
+		}
+	} else {
+		zoneName := d.Get("name").(string)
 
-	zone, err := getZoneByName(ctx, client, zoneName)
-	if err != nil {
-		return diag.FromErr(err)
+		log.Println(msgGet(objectZone, zoneName))
+
+		zone, err = getZoneByName(ctx, client, zoneName)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	err = setZoneToResourceData(d, zone)
 	if err != nil {
-		return diag.FromErr(errGettingObject(objectZone, zoneName, err))
+		return diag.FromErr(errGettingObject(objectZone, zone.Name, err))
+	}
+
+	nameServers, err := getZoneNameServersV2(ctx, client, zone)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	d.Set("name_servers", nameServers)
 
 	return nil
 }
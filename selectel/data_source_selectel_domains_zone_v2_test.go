@@ -20,6 +20,7 @@ func TestAccDomainsZoneV2DataSourceBasic(t *testing.T) {
 				Config: testAccDomainsZoneV2DataSourceBasic(testProjectName, resourceZoneName, testZoneName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccDomainsZoneV2Exists(fmt.Sprintf("data.selectel_domains_zone_v2.%[1]s", resourceZoneName)),
+					resource.TestCheckResourceAttrSet(fmt.Sprintf("data.selectel_domains_zone_v2.%[1]s", resourceZoneName), "name_servers.0"),
 					resource.TestCheckResourceAttr(fmt.Sprintf("data.selectel_domains_zone_v2.%[1]s", resourceZoneName), "name", testZoneName),
 				),
 			},
@@ -36,3 +37,32 @@ func testAccDomainsZoneV2DataSourceBasic(projectName, resourceName, zoneName str
 	}
 `, testAccDomainsZoneV2Basic(projectName, resourceName, zoneName), resourceName, zoneName)
 }
+
+func TestAccDomainsZoneV2DataSourceByID(t *testing.T) {
+	testProjectName := acctest.RandomWithPrefix("tf-acc")
+	testZoneName := fmt.Sprintf("%s.ru.", acctest.RandomWithPrefix("tf-acc"))
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSelectelPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDomainsV2ZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainsZoneV2DataSourceByID(testProjectName, resourceZoneName, testZoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(fmt.Sprintf("data.selectel_domains_zone_v2.%[1]s", resourceZoneName), "id", fmt.Sprintf("selectel_domains_zone_v2.%[1]s", resourceZoneName), "id"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("data.selectel_domains_zone_v2.%[1]s", resourceZoneName), "name", testZoneName),
+				),
+			},
+		},
+	})
+}
+
+func testAccDomainsZoneV2DataSourceByID(projectName, resourceName, zoneName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+	data "selectel_domains_zone_v2" %[2]q {
+	  id = selectel_domains_zone_v2.%[2]s.id
+	  project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+	}
+`, testAccDomainsZoneV2Basic(projectName, resourceName, zoneName), resourceName)
+}
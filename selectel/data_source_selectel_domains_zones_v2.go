@@ -0,0 +1,180 @@
+package selectel
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	domainsV2 "github.com/selectel/domains-go/pkg/v2"
+)
+
+type zoneSearchFilter struct {
+	nameSuffix string
+}
+
+func dataSourceDomainsZonesV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDomainsZonesV2Read,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name_suffix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"zones": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"comment": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"delegation_checked_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_check_status": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"last_delegated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"disabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDomainsZonesV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getDomainsV2Client(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filter := expandZoneSearchFilter(d.Get("filter").(*schema.Set))
+
+	log.Println(msgGet(objectZone, d.Get("project_id").(string)))
+
+	zones, err := listAllZones(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	zones = filterZones(zones, filter)
+
+	zoneIDs := []string{}
+	for _, zone := range zones {
+		zoneIDs = append(zoneIDs, zone.ID)
+	}
+
+	if err := d.Set("zones", flattenDomainsZonesV2(zones)); err != nil {
+		return diag.FromErr(err)
+	}
+	checksum, err := stringListChecksum(zoneIDs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(checksum)
+
+	return nil
+}
+
+func expandZoneSearchFilter(filterSet *schema.Set) zoneSearchFilter {
+	filter := zoneSearchFilter{}
+	if filterSet.Len() == 0 {
+		return filter
+	}
+
+	resourceFilterMap := filterSet.List()[0].(map[string]interface{})
+
+	if nameSuffix, ok := resourceFilterMap["name_suffix"]; ok {
+		filter.nameSuffix = nameSuffix.(string)
+	}
+
+	return filter
+}
+
+// filterZones returns zones matching the filter sorted by name.
+func filterZones(zones []*domainsV2.Zone, filter zoneSearchFilter) []*domainsV2.Zone {
+	var filteredZones []*domainsV2.Zone
+	for _, zone := range zones {
+		if filter.nameSuffix != "" && !hasDomainNameSuffix(zone.Name, filter.nameSuffix) {
+			continue
+		}
+		filteredZones = append(filteredZones, zone)
+	}
+	sort.Slice(filteredZones, func(i, j int) bool {
+		return filteredZones[i].Name < filteredZones[j].Name
+	})
+
+	return filteredZones
+}
+
+// hasDomainNameSuffix reports whether name equals suffix or is a subdomain of it. The
+// comparison ignores case and the trailing dot.
+func hasDomainNameSuffix(name, suffix string) bool {
+	name, suffix = fqdn(name), fqdn(suffix)
+	if strings.HasPrefix(suffix, ".") {
+		return strings.HasSuffix(name, suffix)
+	}
+
+	return name == suffix || strings.HasSuffix(name, "."+suffix)
+}
+
+func flattenDomainsZonesV2(zones []*domainsV2.Zone) []interface{} {
+	result := make([]interface{}, len(zones))
+	for i, zone := range zones {
+		result[i] = map[string]interface{}{
+			"id":                    zone.ID,
+			"name":                  zone.Name,
+			"comment":               zone.Comment,
+			"created_at":            zone.CreatedAt.Format(time.RFC3339),
+			"updated_at":            zone.UpdatedAt.Format(time.RFC3339),
+			"delegation_checked_at": zone.DelegationCheckedAt.Format(time.RFC3339),
+			"last_check_status":     zone.LastCheckStatus,
+			"last_delegated_at":     zone.LastDelegatedAt.Format(time.RFC3339),
+			"disabled":              zone.Disabled,
+		}
+	}
+
+	return result
+}
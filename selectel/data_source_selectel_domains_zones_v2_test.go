@@ -0,0 +1,81 @@
+package selectel
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	domainsV2 "github.com/selectel/domains-go/pkg/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccDomainsZonesV2DataSourceBasic(t *testing.T) {
+	testProjectName := acctest.RandomWithPrefix("tf-acc")
+	testZoneName := fmt.Sprintf("%s.ru.", acctest.RandomWithPrefix("tf-acc"))
+	dataSourceName := fmt.Sprintf("data.selectel_domains_zones_v2.%s", resourceZoneName)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSelectelPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDomainsV2ZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainsZonesV2DataSourceBasic(testProjectName, resourceZoneName, testZoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "zones.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "zones.0.name", testZoneName),
+				),
+			},
+		},
+	})
+}
+
+func testAccDomainsZonesV2DataSourceBasic(projectName, resourceName, zoneName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+	data "selectel_domains_zones_v2" %[2]q {
+	  project_id = "${selectel_vpc_project_v2.project_tf_acc_test_1.id}"
+	  filter {
+	    name_suffix = selectel_domains_zone_v2.%[2]s.name
+	  }
+	}
+`, testAccDomainsZoneV2Basic(projectName, resourceName, zoneName), resourceName)
+}
+
+func TestListAllZones(t *testing.T) {
+	mDNSClient := new(mockedDNSv2Client)
+	ctx := context.Background()
+	nextOffset := 1
+	opts1 := &map[string]string{"limit": "1000", "offset": "0"}
+	opts2 := &map[string]string{"limit": "1000", "offset": strconv.Itoa(nextOffset)}
+	mDNSClient.On("ListZones", ctx, opts1).Return(domainsV2.Listable[domainsV2.Zone](domainsV2.List[domainsV2.Zone]{
+		Count:      1,
+		NextOffset: nextOffset,
+		Items:      []*domainsV2.Zone{{ID: "mocked-uuid-1", Name: "a.xyz."}},
+	}), nil)
+	mDNSClient.On("ListZones", ctx, opts2).Return(domainsV2.Listable[domainsV2.Zone](domainsV2.List[domainsV2.Zone]{
+		Count: 1,
+		Items: []*domainsV2.Zone{{ID: "mocked-uuid-2", Name: "b.xyz."}},
+	}), nil)
+
+	zones, err := listAllZones(ctx, mDNSClient)
+
+	assert.NoError(t, err)
+	assert.Len(t, zones, 2)
+	assert.Equal(t, "mocked-uuid-2", zones[1].ID)
+}
+
+func TestFilterZones(t *testing.T) {
+	zones := []*domainsV2.Zone{
+		{ID: "1", Name: "www.example.com."},
+		{ID: "2", Name: "example.com."},
+		{ID: "3", Name: "notexample.com."},
+		{ID: "4", Name: "example.org."},
+	}
+
+	assert.Equal(t, []*domainsV2.Zone{zones[1], zones[3], zones[2], zones[0]}, filterZones(zones, zoneSearchFilter{}))
+	assert.Equal(t, []*domainsV2.Zone{zones[1], zones[0]}, filterZones(zones, zoneSearchFilter{nameSuffix: "Example.com"}))
+	assert.Equal(t, []*domainsV2.Zone{zones[0]}, filterZones(zones, zoneSearchFilter{nameSuffix: ".example.com."}))
+}
@@ -3,10 +3,12 @@ package selectel
 import (
 	"context"
 	"crypto/md5"
+	crand "crypto/rand"
 	"errors"
 	"fmt"
 	"log"
 	"math"
+	"math/big"
 	"math/rand" // nosemgrep: go.lang.security.audit.crypto.math_random.math-random-used
 	"sort"
 	"strconv"
@@ -29,10 +31,19 @@ const (
 	replicaRole              = "REPLICA"
 )
 
+const (
+	dbaasGeneratedPasswordLength = 32
+	dbaasPasswordLowerChars      = "abcdefghijklmnopqrstuvwxyz"
+	dbaasPasswordUpperChars      = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	dbaasPasswordDigitChars      = "0123456789"
+)
+
 func getDBaaSClient(d *schema.ResourceData, meta interface{}) (*dbaas.API, diag.Diagnostics) {
+	return getDBaaSClientForProject(meta, d.Get("project_id").(string), d.Get("region").(string))
+}
+
+func getDBaaSClientForProject(meta interface{}, projectID, region string) (*dbaas.API, diag.Diagnostics) {
 	config := meta.(*Config)
-	projectID := d.Get("project_id").(string)
-	region := d.Get("region").(string)
 
 	selvpcClient, err := config.GetSelVPCClientWithProjectScope(projectID)
 	if err != nil {
@@ -96,6 +107,63 @@ func convertFieldToStringByType(field interface{}) string {
 	}
 }
 
+// generateDBaaSPassword returns a random alphanumeric password that contains
+// at least one lowercase letter, one uppercase letter and one digit.
+func generateDBaaSPassword() (string, error) {
+	charsets := []string{dbaasPasswordLowerChars, dbaasPasswordUpperChars, dbaasPasswordDigitChars}
+	allChars := strings.Join(charsets, "")
+
+	password := make([]byte, dbaasGeneratedPasswordLength)
+	for i := range password {
+		chars := allChars
+		if i < len(charsets) {
+			chars = charsets[i]
+		}
+		n, err := crand.Int(crand.Reader, big.NewInt(int64(len(chars))))
+		if err != nil {
+			return "", fmt.Errorf("can't generate password: %w", err)
+		}
+		password[i] = chars[n.Int64()]
+	}
+
+	// Shuffle so the required character classes are not always at the start.
+	for i := len(password) - 1; i > 0; i-- {
+		n, err := crand.Int(crand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", fmt.Errorf("can't generate password: %w", err)
+		}
+		j := n.Int64()
+		password[i], password[j] = password[j], password[i]
+	}
+
+	return string(password), nil
+}
+
+// dbaasPasswordFromConfig returns the password set in the configuration
+// or generates a new one if the attribute is omitted.
+func dbaasPasswordFromConfig(d *schema.ResourceData, key string) (string, error) {
+	if !d.GetRawConfig().GetAttr(key).IsNull() {
+		return d.Get(key).(string), nil
+	}
+
+	return generateDBaaSPassword()
+}
+
+// refreshDBaaSGeneratedPasswordDiff marks a generated password as unknown
+// when its version changes, so that a new password is generated on apply.
+func refreshDBaaSGeneratedPasswordDiff(passwordKey, versionKey string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+		if diff.Id() == "" || !diff.HasChange(versionKey) {
+			return nil
+		}
+		if !diff.GetRawConfig().GetAttr(passwordKey).IsNull() {
+			return nil
+		}
+
+		return diff.SetNewComputed(passwordKey)
+	}
+}
+
 func RandomWithPrefix(name string) string {
 	return fmt.Sprintf("%s_%d", name, rand.New(rand.NewSource(time.Now().UnixNano())).Int())
 }
@@ -350,11 +418,15 @@ func resizeDatastore(ctx context.Context, d *schema.ResourceData, client *dbaas.
 		if newFlavor.DiskType != oldFlavor.DiskType {
 			return errors.New("flavor disk type cannot be changed")
 		}
-		// Api does'not support resize using flavor disk_type
-		resizeOpts.Flavor = &dbaas.Flavor{
-			Vcpus: newFlavor.Vcpus,
-			RAM:   newFlavor.RAM,
-			Disk:  newFlavor.Disk,
+		if isDatastoreDiskOnlyResize(oldFlavor, newFlavor) {
+			resizeOpts.Disk = &dbaas.ResizeDisk{Size: newFlavor.Disk}
+		} else {
+			// Api does'not support resize using flavor disk_type
+			resizeOpts.Flavor = &dbaas.Flavor{
+				Vcpus: newFlavor.Vcpus,
+				RAM:   newFlavor.RAM,
+				Disk:  newFlavor.Disk,
+			}
 		}
 	}
 	log.Print(msgUpdate(objectDatastore, d.Id(), resizeOpts))
@@ -373,6 +445,102 @@ func resizeDatastore(ctx context.Context, d *schema.ResourceData, client *dbaas.
 	return nil
 }
 
+// isDatastoreDiskOnlyResize reports whether only the size of a network disk grows,
+// so the datastore can be resized without changing vCPUs and RAM.
+func isDatastoreDiskOnlyResize(oldFlavor, newFlavor *dbaas.Flavor) bool {
+	if oldFlavor == nil || newFlavor == nil {
+		return false
+	}
+
+	return newFlavor.DiskType == dbaas.DiskNetworkUltra &&
+		oldFlavor.DiskType == newFlavor.DiskType &&
+		oldFlavor.Vcpus == newFlavor.Vcpus &&
+		oldFlavor.RAM == newFlavor.RAM &&
+		oldFlavor.Disk < newFlavor.Disk
+}
+
+// validateDatastoreFlavorChange rejects flavor changes that the resize API can't
+// apply. A new flavor_id is resolved with the flavors API and compared with the
+// current flavor of the datastore.
+func validateDatastoreFlavorChange(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if d.HasChange("flavor_id") && d.NewValueKnown("flavor_id") && d.Get("flavor_id").(string) != "" {
+		return validateDatastoreFlavorIDChange(ctx, d, meta)
+	}
+	if !d.HasChange("flavor") || !d.NewValueKnown("flavor") {
+		return nil
+	}
+
+	oldFlavorRaw, newFlavorRaw := d.GetChange("flavor")
+	oldFlavor, err := resourceDBaaSDatastoreV1FlavorFromSet(oldFlavorRaw.(*schema.Set))
+	if err != nil {
+		return errParseDatastoreV1Resize(err)
+	}
+	newFlavor, err := resourceDBaaSDatastoreV1FlavorFromSet(newFlavorRaw.(*schema.Set))
+	if err != nil {
+		return errParseDatastoreV1Resize(err)
+	}
+
+	return checkDatastoreFlavorChange(oldFlavor, newFlavor)
+}
+
+func validateDatastoreFlavorIDChange(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	oldFlavorRaw, _ := d.GetChange("flavor")
+	oldFlavor, err := resourceDBaaSDatastoreV1FlavorFromSet(oldFlavorRaw.(*schema.Set))
+	if err != nil {
+		return errParseDatastoreV1Resize(err)
+	}
+	if oldFlavor == nil {
+		return nil
+	}
+
+	dbaasClient, diagErr := getDBaaSClientForProject(meta, d.Get("project_id").(string), d.Get("region").(string))
+	if diagErr != nil {
+		return errors.New(diagErr[0].Summary)
+	}
+
+	flavorID := d.Get("flavor_id").(string)
+	flavor, err := dbaasClient.Flavor(ctx, flavorID)
+	if err != nil {
+		return errGettingObject(objectFlavors, flavorID, err)
+	}
+
+	return checkDatastoreFlavorIDChange(oldFlavor, flavor)
+}
+
+// checkDatastoreFlavorIDChange compares the current flavor with a flavor from the
+// flavors API. The API doesn't return the disk type, so the current one is kept.
+// A network-ultra volume can be grown on its own, so it can be larger than the
+// default disk of any flavor and its size is not compared.
+func checkDatastoreFlavorIDChange(oldFlavor *dbaas.Flavor, flavor dbaas.FlavorResponse) error {
+	if oldFlavor == nil || oldFlavor.DiskType == dbaas.DiskNetworkUltra {
+		return nil
+	}
+
+	return checkDatastoreFlavorChange(oldFlavor, &dbaas.Flavor{
+		Vcpus:    flavor.Vcpus,
+		RAM:      flavor.RAM,
+		Disk:     flavor.Disk,
+		DiskType: oldFlavor.DiskType,
+	})
+}
+
+func checkDatastoreFlavorChange(oldFlavor, newFlavor *dbaas.Flavor) error {
+	if oldFlavor == nil || newFlavor == nil {
+		return nil
+	}
+	if oldFlavor.DiskType != "" && newFlavor.DiskType != oldFlavor.DiskType {
+		return fmt.Errorf("flavor disk type cannot be changed from %s to %s", oldFlavor.DiskType, newFlavor.DiskType)
+	}
+	if newFlavor.Disk < oldFlavor.Disk {
+		return fmt.Errorf("flavor disk cannot be shrunk from %d to %d GB", oldFlavor.Disk, newFlavor.Disk)
+	}
+
+	return nil
+}
+
 func containDatastoreType(expectedTypes []string, datastoreType string) bool {
 	for _, expectedType := range expectedTypes {
 		if expectedType == datastoreType {
@@ -406,6 +574,60 @@ func validateDatastoreType(ctx context.Context, expectedDatastoreTypeEngines []s
 	return nil
 }
 
+// validateImportedDatastoreType checks that an imported datastore has one of the
+// expected engines. It keeps a datastore from being imported into a resource
+// for another engine, for example when moving away from selectel_dbaas_datastore_v1.
+func validateImportedDatastoreType(ctx context.Context, d *schema.ResourceData, meta interface{}, datastoreID string, expectedDatastoreTypeEngines []string) error {
+	dbaasClient, diagErr := getDBaaSClient(d, meta)
+	if diagErr != nil {
+		return errors.New(diagErr[0].Summary)
+	}
+
+	datastore, err := dbaasClient.Datastore(ctx, datastoreID)
+	if err != nil {
+		return errGettingObject(objectDatastore, datastoreID, err)
+	}
+
+	diagErr = validateDatastoreType(ctx, expectedDatastoreTypeEngines, datastore.TypeID, dbaasClient)
+	if diagErr != nil {
+		return errors.New(diagErr[0].Summary)
+	}
+
+	return nil
+}
+
+// validateImportedDatabaseType checks that an imported database belongs to a
+// datastore with one of the expected engines.
+func validateImportedDatabaseType(ctx context.Context, d *schema.ResourceData, meta interface{}, expectedDatastoreTypeEngines []string) error {
+	dbaasClient, diagErr := getDBaaSClient(d, meta)
+	if diagErr != nil {
+		return errors.New(diagErr[0].Summary)
+	}
+
+	database, err := dbaasClient.Database(ctx, d.Id())
+	if err != nil {
+		return errGettingObject(objectDatabase, d.Id(), err)
+	}
+
+	return validateImportedDatastoreType(ctx, d, meta, database.DatastoreID, expectedDatastoreTypeEngines)
+}
+
+// validateImportedExtensionType checks that an imported extension belongs to a
+// datastore with one of the expected engines.
+func validateImportedExtensionType(ctx context.Context, d *schema.ResourceData, meta interface{}, expectedDatastoreTypeEngines []string) error {
+	dbaasClient, diagErr := getDBaaSClient(d, meta)
+	if diagErr != nil {
+		return errors.New(diagErr[0].Summary)
+	}
+
+	extension, err := dbaasClient.Extension(ctx, d.Id())
+	if err != nil {
+		return errGettingObject(objectExtension, d.Id(), err)
+	}
+
+	return validateImportedDatastoreType(ctx, d, meta, extension.DatastoreID, expectedDatastoreTypeEngines)
+}
+
 func getDatastoreMasterInstance(datastore dbaas.Datastore) (masterInstance dbaas.Instances, found bool) {
 	for _, instance := range datastore.Instances {
 		if instance.Role == masterRole {
@@ -10,12 +10,16 @@ import (
 )
 
 func updateRedisDatastorePassword(ctx context.Context, d *schema.ResourceData, client *dbaas.API) error {
+	redisPassword, err := dbaasPasswordFromConfig(d, "redis_password")
+	if err != nil {
+		return errUpdatingObject(objectDatastore, d.Id(), err)
+	}
 	passwordOpts := dbaas.DatastorePasswordOpts{
-		RedisPassword: d.Get("redis_password").(string),
+		RedisPassword: redisPassword,
 	}
 
 	log.Print(msgUpdate(objectDatastore, d.Id(), passwordOpts))
-	_, err := client.PasswordDatastore(ctx, d.Id(), passwordOpts)
+	_, err = client.PasswordDatastore(ctx, d.Id(), passwordOpts)
 	if err != nil {
 		return errUpdatingObject(objectDatastore, d.Id(), err)
 	}
@@ -26,6 +30,7 @@ func updateRedisDatastorePassword(ctx context.Context, d *schema.ResourceData, c
 	if err != nil {
 		return errUpdatingObject(objectDatastore, d.Id(), err)
 	}
+	d.Set("redis_password", redisPassword)
 
 	return nil
 }
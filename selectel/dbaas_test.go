@@ -3,10 +3,13 @@ package selectel
 import (
 	"context"
 	"fmt"
+	"strings"
+	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/selectel/dbaas-go"
+	"github.com/stretchr/testify/assert"
 )
 
 func newTestDBaaSClient(_ context.Context, rs *terraform.ResourceState, testAccProvider *schema.Provider) (*dbaas.API, error) {
@@ -39,3 +42,57 @@ func newTestDBaaSClient(_ context.Context, rs *terraform.ResourceState, testAccP
 
 	return dbaasClient, nil
 }
+
+func TestGenerateDBaaSPassword(t *testing.T) {
+	password, err := generateDBaaSPassword()
+	assert.NoError(t, err)
+	assert.Len(t, password, dbaasGeneratedPasswordLength)
+	assert.True(t, strings.ContainsAny(password, dbaasPasswordLowerChars))
+	assert.True(t, strings.ContainsAny(password, dbaasPasswordUpperChars))
+	assert.True(t, strings.ContainsAny(password, dbaasPasswordDigitChars))
+
+	anotherPassword, err := generateDBaaSPassword()
+	assert.NoError(t, err)
+	assert.NotEqual(t, password, anotherPassword)
+}
+
+func TestIsDatastoreDiskOnlyResize(t *testing.T) {
+	networkFlavor := &dbaas.Flavor{Vcpus: 2, RAM: 4096, Disk: 32, DiskType: dbaas.DiskNetworkUltra}
+
+	assert.True(t, isDatastoreDiskOnlyResize(networkFlavor, &dbaas.Flavor{Vcpus: 2, RAM: 4096, Disk: 64, DiskType: dbaas.DiskNetworkUltra}))
+	assert.False(t, isDatastoreDiskOnlyResize(networkFlavor, &dbaas.Flavor{Vcpus: 4, RAM: 4096, Disk: 64, DiskType: dbaas.DiskNetworkUltra}))
+	assert.False(t, isDatastoreDiskOnlyResize(networkFlavor, networkFlavor))
+	assert.False(t, isDatastoreDiskOnlyResize(
+		&dbaas.Flavor{Vcpus: 2, RAM: 4096, Disk: 32, DiskType: dbaas.DiskLocal},
+		&dbaas.Flavor{Vcpus: 2, RAM: 4096, Disk: 64, DiskType: dbaas.DiskLocal},
+	))
+	assert.False(t, isDatastoreDiskOnlyResize(nil, networkFlavor))
+}
+
+func TestCheckDatastoreFlavorChange(t *testing.T) {
+	oldFlavor := &dbaas.Flavor{Vcpus: 2, RAM: 4096, Disk: 64, DiskType: dbaas.DiskLocal}
+
+	assert.NoError(t, checkDatastoreFlavorChange(oldFlavor, &dbaas.Flavor{Vcpus: 4, RAM: 8192, Disk: 64, DiskType: dbaas.DiskLocal}))
+	assert.NoError(t, checkDatastoreFlavorChange(oldFlavor, &dbaas.Flavor{Vcpus: 2, RAM: 4096, Disk: 128, DiskType: dbaas.DiskLocal}))
+	assert.NoError(t, checkDatastoreFlavorChange(nil, oldFlavor))
+
+	err := checkDatastoreFlavorChange(oldFlavor, &dbaas.Flavor{Vcpus: 2, RAM: 4096, Disk: 32, DiskType: dbaas.DiskLocal})
+	assert.EqualError(t, err, "flavor disk cannot be shrunk from 64 to 32 GB")
+
+	err = checkDatastoreFlavorChange(oldFlavor, &dbaas.Flavor{Vcpus: 2, RAM: 4096, Disk: 64, DiskType: dbaas.DiskNetworkUltra})
+	assert.EqualError(t, err, "flavor disk type cannot be changed from local to network-ultra")
+}
+
+func TestCheckDatastoreFlavorIDChange(t *testing.T) {
+	flavor := dbaas.FlavorResponse{ID: "flavor-id", Vcpus: 4, RAM: 8192, Disk: 32}
+
+	assert.NoError(t, checkDatastoreFlavorIDChange(&dbaas.Flavor{Vcpus: 2, RAM: 4096, Disk: 32, DiskType: dbaas.DiskLocal}, flavor))
+	assert.NoError(t, checkDatastoreFlavorIDChange(nil, flavor))
+
+	err := checkDatastoreFlavorIDChange(&dbaas.Flavor{Vcpus: 2, RAM: 4096, Disk: 64, DiskType: dbaas.DiskLocal}, flavor)
+	assert.EqualError(t, err, "flavor disk cannot be shrunk from 64 to 32 GB")
+
+	// A network-ultra volume grown with a disk-only resize is larger than the
+	// default disk of the new flavor.
+	assert.NoError(t, checkDatastoreFlavorIDChange(&dbaas.Flavor{Vcpus: 2, RAM: 4096, Disk: 64, DiskType: dbaas.DiskNetworkUltra}, flavor))
+}
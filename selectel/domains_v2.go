@@ -17,8 +17,11 @@ import (
 var ErrProjectIDNotSetupForDNSV2 = errors.New("env variable INFRA_PROJECT_ID or variable project_id must be set for the dns v2")
 
 func getDomainsV2Client(d *schema.ResourceData, meta interface{}) (domainsV2.DNSClient[domainsV2.Zone, domainsV2.RRSet], error) {
+	return getDomainsV2ClientForProject(meta, d.Get("project_id").(string))
+}
+
+func getDomainsV2ClientForProject(meta interface{}, projectID string) (domainsV2.DNSClient[domainsV2.Zone, domainsV2.RRSet], error) {
 	config := meta.(*Config)
-	projectID := d.Get("project_id").(string)
 
 	selvpcClient, err := config.GetSelVPCClientWithProjectScope(projectID)
 	if err != nil {
@@ -104,6 +107,60 @@ func getRRSetByNameAndType(ctx context.Context, client domainsV2.DNSClient[domai
 	return nil, errGettingObject(objectRRSet, fmt.Sprintf("Name: %s. Type: %s.", rrsetName, rrsetType), ErrRRSetNotFound)
 }
 
+// listAllRRSets returns all RRSets of the zone, paging through ListRRSets.
+func listAllRRSets(ctx context.Context, client domainsV2.DNSClient[domainsV2.Zone, domainsV2.RRSet], zoneID string) ([]*domainsV2.RRSet, error) {
+	return listRRSetsByType(ctx, client, zoneID, "")
+}
+
+// listRRSetsByType pages through RRSets of the zone. An empty rrsetType lists RRSets
+// of all types.
+func listRRSetsByType(ctx context.Context, client domainsV2.DNSClient[domainsV2.Zone, domainsV2.RRSet], zoneID, rrsetType string) ([]*domainsV2.RRSet, error) {
+	optsForListRRSets := map[string]string{
+		"limit":  "1000",
+		"offset": "0",
+	}
+	if rrsetType != "" {
+		optsForListRRSets["rrset_types"] = rrsetType
+	}
+
+	var result []*domainsV2.RRSet
+	for {
+		rrsets, err := client.ListRRSets(ctx, zoneID, &optsForListRRSets)
+		if err != nil {
+			return nil, errGettingObjects(objectRRSet, err)
+		}
+		result = append(result, rrsets.GetItems()...)
+		optsForListRRSets["offset"] = strconv.Itoa(rrsets.GetNextOffset())
+		if rrsets.GetNextOffset() == 0 {
+			break
+		}
+	}
+
+	return result, nil
+}
+
+func listAllZones(ctx context.Context, client domainsV2.DNSClient[domainsV2.Zone, domainsV2.RRSet]) ([]*domainsV2.Zone, error) {
+	optsForListZones := map[string]string{
+		"limit":  "1000",
+		"offset": "0",
+	}
+
+	var result []*domainsV2.Zone
+	for {
+		zones, err := client.ListZones(ctx, &optsForListZones)
+		if err != nil {
+			return nil, errGettingObjects(objectZone, err)
+		}
+		result = append(result, zones.GetItems()...)
+		optsForListZones["offset"] = strconv.Itoa(zones.GetNextOffset())
+		if zones.GetNextOffset() == 0 {
+			break
+		}
+	}
+
+	return result, nil
+}
+
 func setZoneToResourceData(d *schema.ResourceData, zone *domainsV2.Zone) error {
 	d.SetId(zone.ID)
 	d.Set("name", zone.Name)
@@ -127,17 +184,35 @@ func setRRSetToResourceData(d *schema.ResourceData, rrset *domainsV2.RRSet) erro
 	d.Set("ttl", rrset.TTL)
 	d.Set("type", rrset.Type)
 	d.Set("zone_id", rrset.ZoneID)
-	d.Set("records", generateSetFromRecords(rrset.Records))
+	d.Set("records", generateSetFromRecords(rrset.Type, rrset.Records, d.Get("records").(*schema.Set)))
 
 	return nil
 }
 
 // generateSetFromRecords - generate terraform TypeList from records in RRSet.
-func generateSetFromRecords(records []domainsV2.RecordItem) []interface{} {
+// Content that is semantically equal to a record in currentSet is kept in the form
+// from currentSet, so TXT values normalized by the API don't cause a diff.
+func generateSetFromRecords(recordType domainsV2.RecordType, records []domainsV2.RecordItem, currentSet *schema.Set) []interface{} {
+	currentContents := map[string]string{}
+	if currentSet != nil {
+		for _, recordItem := range currentSet.List() {
+			record, isOk := recordItem.(map[string]interface{})
+			if !isOk {
+				continue
+			}
+			content := record["content"].(string)
+			currentContents[canonicalRRSetV2RecordContent(recordType, content)] = content
+		}
+	}
+
 	recordsAsList := []interface{}{}
 	for _, record := range records {
+		content := record.Content
+		if currentContent, ok := currentContents[canonicalRRSetV2RecordContent(recordType, content)]; ok {
+			content = currentContent
+		}
 		recordsAsList = append(recordsAsList, map[string]interface{}{
-			"content":  record.Content,
+			"content":  content,
 			"disabled": record.Disabled,
 		})
 	}
@@ -146,7 +221,8 @@ func generateSetFromRecords(records []domainsV2.RecordItem) []interface{} {
 }
 
 // generateRecordsFromSet - generate records for RRSet from terraform TypeList.
-func generateRecordsFromSet(recordsSet *schema.Set) []domainsV2.RecordItem {
+// Raw TXT values are split into quoted strings.
+func generateRecordsFromSet(recordType domainsV2.RecordType, recordsSet *schema.Set) []domainsV2.RecordItem {
 	records := []domainsV2.RecordItem{}
 	for _, recordItem := range recordsSet.List() {
 		record, isOk := recordItem.(map[string]interface{})
@@ -154,7 +230,7 @@ func generateRecordsFromSet(recordsSet *schema.Set) []domainsV2.RecordItem {
 			continue
 		}
 		records = append(records, domainsV2.RecordItem{
-			Content:  record["content"].(string),
+			Content:  expandRRSetV2RecordContent(recordType, record["content"].(string)),
 			Disabled: record["disabled"].(bool),
 		})
 	}
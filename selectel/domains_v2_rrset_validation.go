@@ -0,0 +1,192 @@
+package selectel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	domainsV2 "github.com/selectel/domains-go/pkg/v2"
+)
+
+var (
+	rrsetV2Types = []string{
+		string(domainsV2.A),
+		string(domainsV2.AAAA),
+		string(domainsV2.TXT),
+		string(domainsV2.CNAME),
+		string(domainsV2.MX),
+		string(domainsV2.NS),
+		string(domainsV2.SRV),
+		string(domainsV2.SSHFP),
+		string(domainsV2.ALIAS),
+		string(domainsV2.CAA),
+	}
+	rrsetV2CAATags = []string{"issue", "issuewild", "iodef", "auth", "path", "policy"}
+
+	dnsLabelRegexp   = regexp.MustCompile(`^[a-zA-Z0-9_]([a-zA-Z0-9_-]{0,61}[a-zA-Z0-9_])?$`)
+	sshfpHexRegexp   = regexp.MustCompile(`^[a-fA-F0-9]+$`)
+	caaTagValueSplit = regexp.MustCompile(`^(\S+)\s+(\S+)\s+(.+)$`)
+)
+
+// validateDomainsRRSetV2Records validates records of the RRSet at plan time. Records
+// with unknown content are skipped.
+func validateDomainsRRSetV2Records(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	rawType := d.GetRawConfig().GetAttr("type")
+	rawRecords := d.GetRawConfig().GetAttr("records")
+	if !rawType.IsKnown() || rawType.IsNull() || !rawRecords.IsKnown() || rawRecords.IsNull() {
+		return nil
+	}
+
+	var contents []string
+	for _, rawRecord := range rawRecords.AsValueSlice() {
+		if !rawRecord.IsKnown() || rawRecord.IsNull() {
+			return nil
+		}
+		rawContent := rawRecord.GetAttr("content")
+		if !rawContent.IsKnown() {
+			return nil
+		}
+		if rawContent.IsNull() {
+			continue
+		}
+		contents = append(contents, rawContent.AsString())
+	}
+
+	return validateRRSetV2Contents(rawType.AsString(), contents)
+}
+
+// validateRRSetV2Contents checks that every record matches the syntax of the RRSet
+// type and that a CNAME RRSet has a single record.
+func validateRRSetV2Contents(recordType string, contents []string) error {
+	if recordType == string(domainsV2.CNAME) && len(contents) != 1 {
+		return fmt.Errorf("CNAME RRSet must contain exactly one record, got %d", len(contents))
+	}
+
+	for _, content := range contents {
+		if err := validateRRSetV2Content(recordType, content); err != nil {
+			return fmt.Errorf("%s record %q: %w", recordType, content, err)
+		}
+	}
+
+	return nil
+}
+
+func validateRRSetV2Content(recordType, content string) error {
+	fields := strings.Fields(content)
+
+	switch domainsV2.RecordType(recordType) {
+	case domainsV2.A:
+		addr, err := netip.ParseAddr(content)
+		if err != nil || !addr.Is4() {
+			return errors.New("must be an IPv4 address")
+		}
+	case domainsV2.AAAA:
+		addr, err := netip.ParseAddr(content)
+		if err != nil || !addr.Is6() || addr.Is4In6() || addr.Zone() != "" {
+			return errors.New("must be an IPv6 address")
+		}
+	case domainsV2.CNAME, domainsV2.ALIAS, domainsV2.NS:
+		return validateRRSetV2FQDN("target", content)
+	case domainsV2.MX:
+		if len(fields) != 2 {
+			return errors.New("must be in the <priority> <host> format")
+		}
+		if err := validateRRSetV2Uint16("priority", fields[0]); err != nil {
+			return err
+		}
+		if fields[1] == "." {
+			return nil
+		}
+
+		return validateRRSetV2FQDN("host", fields[1])
+	case domainsV2.SRV:
+		if len(fields) != 4 {
+			return errors.New("must be in the <priority> <weight> <port> <target> format")
+		}
+		for i, name := range []string{"priority", "weight", "port"} {
+			if err := validateRRSetV2Uint16(name, fields[i]); err != nil {
+				return err
+			}
+		}
+		if fields[3] == "." {
+			return nil
+		}
+
+		return validateRRSetV2FQDN("target", fields[3])
+	case domainsV2.SSHFP:
+		if len(fields) != 3 {
+			return errors.New("must be in the <algorithm> <fingerprint_type> <fingerprint> format")
+		}
+		if n, err := strconv.Atoi(fields[0]); err != nil || (n < 1 || n > 4) && n != 6 {
+			return errors.New("algorithm must be one of 1, 2, 3, 4, 6")
+		}
+		if n, err := strconv.Atoi(fields[1]); err != nil || n < 1 || n > 2 {
+			return errors.New("fingerprint_type must be 1 or 2")
+		}
+		if !sshfpHexRegexp.MatchString(fields[2]) {
+			return errors.New("fingerprint must be a hexadecimal string")
+		}
+	case domainsV2.CAA:
+		parts := caaTagValueSplit.FindStringSubmatch(content)
+		if parts == nil {
+			return errors.New("must be in the <flag> <tag> <value> format")
+		}
+		if n, err := strconv.Atoi(parts[1]); err != nil || n < 0 || n > 128 {
+			return errors.New("flag must be from 0 to 128")
+		}
+		if !containsString(rrsetV2CAATags, parts[2]) {
+			return fmt.Errorf("tag must be one of %s", strings.Join(rrsetV2CAATags, ", "))
+		}
+		chunks, err := splitTXTChunks(parts[3])
+		if err != nil || len(chunks) != 1 {
+			return errors.New("value must be a single quoted string")
+		}
+	case domainsV2.TXT:
+		if !isQuotedTXTContent(content) {
+			return nil
+		}
+		chunks, err := splitTXTChunks(content)
+		if err != nil {
+			return err
+		}
+		for _, chunk := range chunks {
+			if len(chunk) > txtChunkMaxLength {
+				return fmt.Errorf("quoted strings must not be longer than %d characters, split the value into several quoted strings", txtChunkMaxLength)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateRRSetV2FQDN checks a domain name in record content. Wildcard labels are
+// only valid in RRSet names, so they are rejected here.
+func validateRRSetV2FQDN(field, name string) error {
+	if !strings.HasSuffix(name, ".") {
+		return fmt.Errorf("%s %q must be a fully qualified domain name with a trailing dot", field, name)
+	}
+	if len(name) > 254 {
+		return fmt.Errorf("%s %q must not be longer than 253 characters", field, name)
+	}
+	for _, label := range strings.Split(strings.TrimSuffix(name, "."), ".") {
+		if !dnsLabelRegexp.MatchString(label) {
+			return fmt.Errorf("%s %q is not a valid domain name", field, name)
+		}
+	}
+
+	return nil
+}
+
+func validateRRSetV2Uint16(field, value string) error {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 || n > 65535 {
+		return fmt.Errorf("%s must be from 0 to 65535", field)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package selectel
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateRRSetV2Contents(t *testing.T) {
+	valid := map[string][]string{
+		"A":     {"192.0.2.1"},
+		"AAAA":  {"2001:db8::1"},
+		"CNAME": {"origin.example.com."},
+		"ALIAS": {"origin.example.com."},
+		"NS":    {"a.ns.selectel.ru.", "b.ns.selectel.ru."},
+		"MX":    {"10 mail.example.org.", "0 ."},
+		"SRV":   {"10 20 5060 _sip.example.org."},
+		"SSHFP": {"1 1 7491973e5f8b39d5327cd4e08bc81b05f7710b49", "6 2 0b3b8c1e5f6a7d9e"},
+		"CAA":   {`0 issue "letsencrypt.org"`, `128 iodef "mailto:admin@example.com"`},
+		"TXT":   {"v=spf1 -all", `"v=spf1 -all"`, `"part one" "part \"two\""`, `"` + strings.Repeat("a", 255) + `"`},
+	}
+	for recordType, contents := range valid {
+		assert.NoError(t, validateRRSetV2Contents(recordType, contents), recordType)
+	}
+
+	testCases := []struct {
+		recordType string
+		contents   []string
+		err        string
+	}{
+		{"A", []string{"2001:db8::1"}, `A record "2001:db8::1": must be an IPv4 address`},
+		{"AAAA", []string{"192.0.2.1"}, `AAAA record "192.0.2.1": must be an IPv6 address`},
+		{"CNAME", []string{"a.example.com.", "b.example.com."}, "CNAME RRSet must contain exactly one record, got 2"},
+		{"CNAME", []string{"origin.example.com"}, `CNAME record "origin.example.com": target "origin.example.com" must be a fully qualified domain name with a trailing dot`},
+		{"CNAME", []string{"www.*.example.com."}, `CNAME record "www.*.example.com.": target "www.*.example.com." is not a valid domain name`},
+		{"NS", []string{"*.example.com."}, `NS record "*.example.com.": target "*.example.com." is not a valid domain name`},
+		{"MX", []string{"10 *.example.com."}, `MX record "10 *.example.com.": host "*.example.com." is not a valid domain name`},
+		{"NS", []string{"bad..example.com."}, `NS record "bad..example.com.": target "bad..example.com." is not a valid domain name`},
+		{"MX", []string{"mail.example.org."}, `MX record "mail.example.org.": must be in the <priority> <host> format`},
+		{"MX", []string{"70000 mail.example.org."}, `MX record "70000 mail.example.org.": priority must be from 0 to 65535`},
+		{"SRV", []string{"10 20 x target.example.org."}, `SRV record "10 20 x target.example.org.": port must be from 0 to 65535`},
+		{"SSHFP", []string{"0 1 7491973e"}, `SSHFP record "0 1 7491973e": algorithm must be one of 1, 2, 3, 4, 6`},
+		{"SSHFP", []string{"5 1 7491973e"}, `SSHFP record "5 1 7491973e": algorithm must be one of 1, 2, 3, 4, 6`},
+		{"SSHFP", []string{"1 0 7491973e"}, `SSHFP record "1 0 7491973e": fingerprint_type must be 1 or 2`},
+		{"SSHFP", []string{"1 1 xyz"}, `SSHFP record "1 1 xyz": fingerprint must be a hexadecimal string`},
+		{"CAA", []string{`129 issue "letsencrypt.org"`}, `CAA record "129 issue \"letsencrypt.org\"": flag must be from 0 to 128`},
+		{"CAA", []string{`0 unknown "x"`}, `CAA record "0 unknown \"x\"": tag must be one of issue, issuewild, iodef, auth, path, policy`},
+		{"CAA", []string{`0 issue letsencrypt.org`}, `CAA record "0 issue letsencrypt.org": value must be a single quoted string`},
+		{"TXT", []string{`"unterminated`}, `TXT record "\"unterminated": quoted string is not terminated`},
+		{"TXT", []string{`"a""b"`}, `TXT record "\"a\"\"b\"": quoted strings must be separated by spaces`},
+	}
+	for _, testCase := range testCases {
+		assert.EqualError(t, validateRRSetV2Contents(testCase.recordType, testCase.contents), testCase.err)
+	}
+
+	err := validateRRSetV2Contents("TXT", []string{`"` + strings.Repeat("a", 256) + `"`})
+	assert.ErrorContains(t, err, "quoted strings must not be longer than 255 characters")
+}
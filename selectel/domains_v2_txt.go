@@ -0,0 +1,161 @@
+package selectel
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	domainsV2 "github.com/selectel/domains-go/pkg/v2"
+)
+
+const txtChunkMaxLength = 255
+
+// isQuotedTXTContent reports whether TXT content is already written as quoted strings.
+// Other content is treated as a raw value.
+func isQuotedTXTContent(content string) bool {
+	return strings.HasPrefix(strings.TrimSpace(content), `"`)
+}
+
+// formatTXTContent splits a raw TXT value into quoted strings of up to 255 bytes
+// separated by spaces. Quotes and backslashes are escaped and UTF-8 characters are
+// never split between strings.
+func formatTXTContent(value string) string {
+	if value == "" {
+		return `""`
+	}
+
+	var chunks []string
+	for len(value) > 0 {
+		end := len(value)
+		if end > txtChunkMaxLength {
+			end = txtChunkMaxLength
+			for end > 0 && !utf8.RuneStart(value[end]) {
+				end--
+			}
+		}
+
+		escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value[:end])
+		chunks = append(chunks, `"`+escaped+`"`)
+		value = value[end:]
+	}
+
+	return strings.Join(chunks, " ")
+}
+
+// expandTXTContent returns TXT content as it is sent to the API. Quoted content is
+// sent as is, raw values are split into quoted strings.
+func expandTXTContent(content string) string {
+	if isQuotedTXTContent(content) {
+		return content
+	}
+
+	return formatTXTContent(content)
+}
+
+// canonicalTXTContent returns a form of TXT content that is equal for semantically
+// equal values, no matter how they are quoted and split into strings.
+func canonicalTXTContent(content string) string {
+	if !isQuotedTXTContent(content) {
+		return formatTXTContent(content)
+	}
+
+	chunks, err := splitTXTChunks(content)
+	if err != nil {
+		return content
+	}
+
+	return formatTXTContent(strings.Join(chunks, ""))
+}
+
+// expandRRSetV2RecordContent returns record content as it is sent to the API.
+func expandRRSetV2RecordContent(recordType domainsV2.RecordType, content string) string {
+	if recordType == domainsV2.TXT {
+		return expandTXTContent(content)
+	}
+
+	return content
+}
+
+// canonicalRRSetV2RecordContent returns record content used to compare records read
+// from the API with records in the state.
+func canonicalRRSetV2RecordContent(recordType domainsV2.RecordType, content string) string {
+	if recordType == domainsV2.TXT {
+		return canonicalTXTContent(content)
+	}
+
+	return content
+}
+
+// splitTXTChunks splits TXT record data into unescaped character strings. The data
+// must be one or more quoted strings separated by spaces, for example "a" "b".
+func splitTXTChunks(content string) ([]string, error) {
+	var (
+		chunks []string
+		chunk  strings.Builder
+	)
+
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return nil, errors.New("must be one or more quoted strings")
+	}
+
+	for i := 0; i < len(content); {
+		if content[i] == ' ' || content[i] == '\t' {
+			i++
+			continue
+		}
+		if content[i] != '"' {
+			return nil, errors.New("must be one or more quoted strings, for example \"v=spf1 -all\"")
+		}
+
+		chunk.Reset()
+		closed := false
+		for i++; i < len(content); i++ {
+			c := content[i]
+			if c == '"' {
+				closed = true
+				i++
+				break
+			}
+			if c != '\\' {
+				chunk.WriteByte(c)
+				continue
+			}
+			if i+1 >= len(content) {
+				break
+			}
+			if i+3 < len(content) && isDigits(content[i+1:i+4]) {
+				n, _ := strconv.Atoi(content[i+1 : i+4])
+				if n > 255 {
+					return nil, fmt.Errorf("invalid escape sequence \\%s", content[i+1:i+4])
+				}
+				chunk.WriteByte(byte(n))
+				i += 3
+				continue
+			}
+			chunk.WriteByte(content[i+1])
+			i++
+		}
+		if !closed {
+			return nil, errors.New("quoted string is not terminated")
+		}
+		if i < len(content) && content[i] != ' ' && content[i] != '\t' {
+			return nil, errors.New("quoted strings must be separated by spaces")
+		}
+		chunks = append(chunks, chunk.String())
+	}
+
+	return chunks, nil
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
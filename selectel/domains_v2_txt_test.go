@@ -0,0 +1,67 @@
+package selectel
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	domainsV2 "github.com/selectel/domains-go/pkg/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitTXTChunks(t *testing.T) {
+	chunks, err := splitTXTChunks(`"v=DKIM1; k=rsa; " "p=MIGf\"\\\065"`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"v=DKIM1; k=rsa; ", `p=MIGf"\A`}, chunks)
+}
+
+func TestFormatTXTContent(t *testing.T) {
+	assert.Equal(t, `""`, formatTXTContent(""))
+	assert.Equal(t, `"v=spf1 include:\"x\" \\ -all"`, formatTXTContent(`v=spf1 include:"x" \ -all`))
+
+	long := strings.Repeat("a", 300)
+	assert.Equal(t, `"`+strings.Repeat("a", 255)+`" "`+strings.Repeat("a", 45)+`"`, formatTXTContent(long))
+
+	// A two-byte character at the boundary is moved to the next string.
+	unicode := strings.Repeat("a", 254) + "я"
+	assert.Equal(t, `"`+strings.Repeat("a", 254)+`" "я"`, formatTXTContent(unicode))
+
+	chunks, err := splitTXTChunks(formatTXTContent(long))
+	assert.NoError(t, err)
+	assert.Equal(t, long, strings.Join(chunks, ""))
+}
+
+func TestCanonicalTXTContent(t *testing.T) {
+	long := strings.Repeat("k", 400)
+	raw := canonicalTXTContent(long)
+
+	assert.Equal(t, raw, canonicalTXTContent(`"`+long[:100]+`" "`+long[100:]+`"`))
+	assert.Equal(t, raw, canonicalTXTContent(formatTXTContent(long)))
+	assert.NotEqual(t, raw, canonicalTXTContent(long[1:]))
+	assert.Equal(t, `"a"`, expandTXTContent(`"a"`))
+	assert.Equal(t, `"a b"`, expandTXTContent(`a b`))
+}
+
+func TestGenerateSetFromRecordsKeepsTXTForm(t *testing.T) {
+	long := strings.Repeat("p", 300)
+	currentSet := schema.NewSet(schema.HashResource(resourceDomainsRRSetV2().Schema["records"].Elem.(*schema.Resource)), []interface{}{
+		map[string]interface{}{"content": long, "disabled": false},
+	})
+
+	records := generateRecordsFromSet(domainsV2.TXT, currentSet)
+	assert.Equal(t, formatTXTContent(long), records[0].Content)
+
+	// The API may split the value differently, the raw value in the state is kept.
+	apiRecords := []domainsV2.RecordItem{
+		{Content: `"` + long[:200] + `" "` + long[200:] + `"`},
+		{Content: `"other"`},
+	}
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"content": long, "disabled": false},
+		map[string]interface{}{"content": `"other"`, "disabled": false},
+	}, generateSetFromRecords(domainsV2.TXT, apiRecords, currentSet))
+
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"content": "192.0.2.1", "disabled": true},
+	}, generateSetFromRecords(domainsV2.A, []domainsV2.RecordItem{{Content: "192.0.2.1", Disabled: true}}, nil))
+}
@@ -0,0 +1,419 @@
+package selectel
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	domainsV2 "github.com/selectel/domains-go/pkg/v2"
+)
+
+// zoneFileRRSet is an RRSet parsed from an RFC 1035 zone file.
+type zoneFileRRSet struct {
+	name       string
+	recordType string
+	ttl        int
+	records    []string
+}
+
+func (r zoneFileRRSet) key() string {
+	return rrsetKey(r.name, r.recordType)
+}
+
+func rrsetKey(name, recordType string) string {
+	return fmt.Sprintf("%s/%s", fqdn(name), strings.ToUpper(recordType))
+}
+
+type zoneFileParseOpts struct {
+	origin     string
+	defaultTTL int
+	// includeApexNS keeps NS records of the zone apex. SOA records are always
+	// skipped because the SOA RRSet is managed by DNS Hosting.
+	includeApexNS bool
+	// anyZone skips the check that owner names are inside of origin. It is used to
+	// validate a zone file before the zone name is known.
+	anyZone bool
+}
+
+var zoneFileSupportedTypes = map[string]struct{}{
+	string(domainsV2.A):     {},
+	string(domainsV2.AAAA):  {},
+	string(domainsV2.ALIAS): {},
+	string(domainsV2.CAA):   {},
+	string(domainsV2.CNAME): {},
+	string(domainsV2.MX):    {},
+	string(domainsV2.NS):    {},
+	string(domainsV2.SOA):   {},
+	string(domainsV2.SRV):   {},
+	string(domainsV2.SSHFP): {},
+	string(domainsV2.TXT):   {},
+}
+
+// zoneFileNameFieldIndexes lists rdata fields that contain domain names and must be
+// made fully qualified.
+var zoneFileNameFieldIndexes = map[string]int{
+	string(domainsV2.ALIAS): 0,
+	string(domainsV2.CNAME): 0,
+	string(domainsV2.NS):    0,
+	string(domainsV2.MX):    1,
+	string(domainsV2.SRV):   3,
+}
+
+// parseZoneFile parses an RFC 1035 zone file and groups its records into RRSets sorted
+// by name and type. $ORIGIN and $TTL directives, parentheses, comments, "@" and
+// relative names are supported. $INCLUDE and classes other than IN are not.
+func parseZoneFile(zoneFile string, opts zoneFileParseOpts) ([]zoneFileRRSet, error) {
+	origin := fqdn(opts.origin)
+	zone := origin
+	ttl := opts.defaultTTL
+	owner := ""
+
+	lines, err := splitZoneFileLines(zoneFile)
+	if err != nil {
+		return nil, err
+	}
+
+	rrsets := map[string]*zoneFileRRSet{}
+	for _, line := range lines {
+		tokens := line.tokens
+		lineErr := func(err error) error {
+			return fmt.Errorf("zone file line %d: %w", line.number, err)
+		}
+
+		if strings.HasPrefix(tokens[0], "$") && !line.indented {
+			switch strings.ToUpper(tokens[0]) {
+			case "$ORIGIN":
+				if len(tokens) != 2 {
+					return nil, lineErr(errors.New("$ORIGIN requires one domain name"))
+				}
+				origin = absoluteZoneFileName(tokens[1], origin)
+			case "$TTL":
+				if len(tokens) != 2 {
+					return nil, lineErr(errors.New("$TTL requires one value"))
+				}
+				ttl, err = parseZoneFileTTL(tokens[1])
+				if err != nil {
+					return nil, lineErr(err)
+				}
+			default:
+				return nil, lineErr(fmt.Errorf("directive %s is not supported", tokens[0]))
+			}
+
+			continue
+		}
+
+		if !line.indented {
+			owner = absoluteZoneFileName(tokens[0], origin)
+			tokens = tokens[1:]
+		}
+		if owner == "" {
+			return nil, lineErr(errors.New("record has no owner name"))
+		}
+		if !opts.anyZone && owner != zone && !strings.HasSuffix(owner, "."+zone) {
+			return nil, lineErr(fmt.Errorf("%s is outside of the zone %s", owner, zone))
+		}
+
+		recordTTL := ttl
+		for len(tokens) > 0 {
+			if strings.EqualFold(tokens[0], "IN") {
+				tokens = tokens[1:]
+				continue
+			}
+			if strings.EqualFold(tokens[0], "CH") || strings.EqualFold(tokens[0], "HS") {
+				return nil, lineErr(fmt.Errorf("class %s is not supported", tokens[0]))
+			}
+			if tokens[0][0] >= '0' && tokens[0][0] <= '9' {
+				recordTTL, err = parseZoneFileTTL(tokens[0])
+				if err != nil {
+					return nil, lineErr(err)
+				}
+				tokens = tokens[1:]
+				continue
+			}
+
+			break
+		}
+		if len(tokens) < 2 {
+			return nil, lineErr(errors.New("record must have a type and data"))
+		}
+		if recordTTL <= 0 {
+			return nil, lineErr(errors.New("record has no TTL, set $TTL or the default TTL"))
+		}
+
+		recordType := strings.ToUpper(tokens[0])
+		if _, ok := zoneFileSupportedTypes[recordType]; !ok {
+			return nil, lineErr(fmt.Errorf("record type %s is not supported", recordType))
+		}
+		if recordType == string(domainsV2.SOA) || (recordType == string(domainsV2.NS) && owner == zone && !opts.includeApexNS) {
+			continue
+		}
+
+		rdata := tokens[1:]
+		if index, ok := zoneFileNameFieldIndexes[recordType]; ok && index < len(rdata) {
+			rdata[index] = absoluteZoneFileName(rdata[index], origin)
+		}
+		if recordType == string(domainsV2.TXT) {
+			for i, token := range rdata {
+				if !isQuotedTXTContent(token) {
+					rdata[i] = formatTXTContent(token)
+				}
+			}
+		}
+		content := strings.Join(rdata, " ")
+		if err := validateRRSetV2Content(recordType, content); err != nil {
+			return nil, lineErr(fmt.Errorf("%s record %q: %w", recordType, content, err))
+		}
+		content = canonicalRRSetV2RecordContent(domainsV2.RecordType(recordType), content)
+
+		key := rrsetKey(owner, recordType)
+		rrset, ok := rrsets[key]
+		if !ok {
+			rrset = &zoneFileRRSet{name: owner, recordType: recordType, ttl: recordTTL}
+			rrsets[key] = rrset
+		}
+		if rrset.ttl != recordTTL {
+			return nil, lineErr(fmt.Errorf("TTL %d of %s %s differs from TTL %d of other records in the RRSet", recordTTL, owner, recordType, rrset.ttl))
+		}
+		if !containsString(rrset.records, content) {
+			rrset.records = append(rrset.records, content)
+		}
+		if recordType == string(domainsV2.CNAME) && len(rrset.records) > 1 {
+			return nil, lineErr(fmt.Errorf("CNAME RRSet %s must contain exactly one record", owner))
+		}
+	}
+
+	result := make([]zoneFileRRSet, 0, len(rrsets))
+	for _, rrset := range rrsets {
+		sort.Strings(rrset.records)
+		result = append(result, *rrset)
+	}
+	sortZoneFileRRSets(result)
+
+	return result, nil
+}
+
+func sortZoneFileRRSets(rrsets []zoneFileRRSet) {
+	sort.Slice(rrsets, func(i, j int) bool {
+		if rrsets[i].name != rrsets[j].name {
+			return rrsets[i].name < rrsets[j].name
+		}
+
+		return rrsets[i].recordType < rrsets[j].recordType
+	})
+}
+
+type zoneFileLine struct {
+	number   int
+	indented bool
+	tokens   []string
+}
+
+// splitZoneFileLines splits a zone file into logical lines of tokens. Comments are
+// removed, lines in parentheses are joined and quoted strings are kept as single
+// tokens with their quotes.
+func splitZoneFileLines(zoneFile string) ([]zoneFileLine, error) {
+	var (
+		lines   []zoneFileLine
+		current *zoneFileLine
+		token   strings.Builder
+		depth   int
+	)
+
+	flushToken := func() {
+		if token.Len() > 0 {
+			current.tokens = append(current.tokens, token.String())
+			token.Reset()
+		}
+	}
+
+	for number, rawLine := range strings.Split(strings.ReplaceAll(zoneFile, "\r\n", "\n"), "\n") {
+		if depth == 0 {
+			current = &zoneFileLine{
+				number:   number + 1,
+				indented: rawLine != "" && (rawLine[0] == ' ' || rawLine[0] == '\t'),
+			}
+		}
+
+		inQuotes := false
+	chars:
+		for i := 0; i < len(rawLine); i++ {
+			c := rawLine[i]
+			switch {
+			case inQuotes && c == '\\' && i+1 < len(rawLine):
+				token.WriteByte(c)
+				token.WriteByte(rawLine[i+1])
+				i++
+			case c == '"':
+				token.WriteByte(c)
+				inQuotes = !inQuotes
+			case inQuotes:
+				token.WriteByte(c)
+			case c == ';':
+				break chars
+			case c == '(':
+				flushToken()
+				depth++
+			case c == ')':
+				flushToken()
+				if depth == 0 {
+					return nil, fmt.Errorf("zone file line %d: unexpected )", number+1)
+				}
+				depth--
+			case c == ' ' || c == '\t':
+				flushToken()
+			default:
+				token.WriteByte(c)
+			}
+		}
+		if inQuotes {
+			return nil, fmt.Errorf("zone file line %d: unterminated quoted string", number+1)
+		}
+		flushToken()
+
+		if depth == 0 && len(current.tokens) > 0 {
+			lines = append(lines, *current)
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("zone file line %d: unterminated (", current.number)
+	}
+
+	return lines, nil
+}
+
+// parseZoneFileTTL parses a TTL in seconds or with BIND units, for example 1h30m.
+func parseZoneFileTTL(value string) (int, error) {
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return seconds, nil
+	}
+
+	units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	total, number := 0, ""
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if c >= '0' && c <= '9' {
+			number += string(c)
+			continue
+		}
+		multiplier, ok := units[c|0x20]
+		if !ok || number == "" {
+			return 0, fmt.Errorf("invalid TTL %q", value)
+		}
+		n, _ := strconv.Atoi(number)
+		total += n * multiplier
+		number = ""
+	}
+	if number != "" {
+		return 0, fmt.Errorf("invalid TTL %q", value)
+	}
+
+	return total, nil
+}
+
+func absoluteZoneFileName(name, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return strings.ToLower(name)
+	case origin == "":
+		return strings.ToLower(name) + "."
+	default:
+		return strings.ToLower(name) + "." + origin
+	}
+}
+
+func fqdn(name string) string {
+	name = strings.ToLower(name)
+	if name == "" || strings.HasSuffix(name, ".") {
+		return name
+	}
+
+	return name + "."
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// renderZoneFile renders RRSets as an RFC 1035 zone file. Names are written relative
+// to the origin, the apex first and the rest sorted by name, then by type and record
+// content, so the output is stable between reads. Records with a TTL equal to
+// defaultTTL are written without a TTL. Disabled records are written as comments.
+func renderZoneFile(origin string, defaultTTL int, rrsets []*domainsV2.RRSet) string {
+	origin = fqdn(origin)
+
+	sorted := make([]*domainsV2.RRSet, len(rrsets))
+	copy(sorted, rrsets)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		nameI, nameJ := relativeZoneFileName(sorted[i].Name, origin), relativeZoneFileName(sorted[j].Name, origin)
+		if nameI != nameJ {
+			if nameI == "@" || nameJ == "@" {
+				return nameI == "@"
+			}
+
+			return nameI < nameJ
+		}
+
+		return zoneFileTypeOrder(string(sorted[i].Type)) < zoneFileTypeOrder(string(sorted[j].Type))
+	})
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "$ORIGIN %s\n", origin)
+	fmt.Fprintf(&b, "$TTL %d\n", defaultTTL)
+	for _, rrset := range sorted {
+		ttl := ""
+		if rrset.TTL != defaultTTL {
+			ttl = strconv.Itoa(rrset.TTL)
+		}
+
+		records := make([]domainsV2.RecordItem, len(rrset.Records))
+		copy(records, rrset.Records)
+		sort.SliceStable(records, func(i, j int) bool {
+			return records[i].Content < records[j].Content
+		})
+
+		for _, record := range records {
+			prefix := ""
+			if record.Disabled {
+				prefix = "; "
+			}
+			fmt.Fprintf(&b, "%s%s\t%s\tIN\t%s\t%s\n", prefix, relativeZoneFileName(rrset.Name, origin), ttl, rrset.Type, record.Content)
+		}
+	}
+
+	return b.String()
+}
+
+// zoneFileTypeOrder puts SOA and NS records first, as they are usually written at the
+// top of a zone file. Other types are sorted alphabetically.
+func zoneFileTypeOrder(recordType string) string {
+	switch recordType {
+	case string(domainsV2.SOA):
+		return "0"
+	case string(domainsV2.NS):
+		return "1"
+	default:
+		return "2" + recordType
+	}
+}
+
+func relativeZoneFileName(name, origin string) string {
+	name = fqdn(name)
+	switch {
+	case name == origin:
+		return "@"
+	case strings.HasSuffix(name, "."+origin):
+		return strings.TrimSuffix(name, "."+origin)
+	default:
+		return name
+	}
+}
@@ -0,0 +1,135 @@
+package selectel
+
+import (
+	"testing"
+
+	domainsV2 "github.com/selectel/domains-go/pkg/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseZoneFile(t *testing.T) {
+	zoneFile := `
+$ORIGIN example.com.
+$TTL 1h
+@       IN SOA ns1.selectel.org. support.selectel.ru. (
+            2024010101 ; serial
+            3600 600 604800 60 )
+@       IN NS  ns1.selectel.org.
+@          NS  ns2.selectel.org.
+@          A   192.0.2.1
+           A   192.0.2.2
+www  300 IN CNAME @
+mail       MX  10 mx ; primary
+           MX  20 mx2.example.net.
+_sip._tcp  SRV 10 60 5060 sip
+txt        TXT "v=spf1 include:_spf.example.net ~all" ; comment
+dkim       TXT "v=DKIM1; " "p=MIGf" unquoted
+sub        NS  ns1.sub
+$ORIGIN sub.example.com.
+ns1        A   192.0.2.53
+`
+
+	rrsets, err := parseZoneFile(zoneFile, zoneFileParseOpts{origin: "example.com", defaultTTL: 60})
+	assert.NoError(t, err)
+	assert.Equal(t, []zoneFileRRSet{
+		{name: "_sip._tcp.example.com.", recordType: "SRV", ttl: 3600, records: []string{"10 60 5060 sip.example.com."}},
+		{name: "dkim.example.com.", recordType: "TXT", ttl: 3600, records: []string{`"v=DKIM1; p=MIGfunquoted"`}},
+		{name: "example.com.", recordType: "A", ttl: 3600, records: []string{"192.0.2.1", "192.0.2.2"}},
+		{name: "mail.example.com.", recordType: "MX", ttl: 3600, records: []string{"10 mx.example.com.", "20 mx2.example.net."}},
+		{name: "ns1.sub.example.com.", recordType: "A", ttl: 3600, records: []string{"192.0.2.53"}},
+		{name: "sub.example.com.", recordType: "NS", ttl: 3600, records: []string{"ns1.sub.example.com."}},
+		{name: "txt.example.com.", recordType: "TXT", ttl: 3600, records: []string{`"v=spf1 include:_spf.example.net ~all"`}},
+		{name: "www.example.com.", recordType: "CNAME", ttl: 300, records: []string{"example.com."}},
+	}, rrsets)
+
+	rrsets, err = parseZoneFile(zoneFile, zoneFileParseOpts{origin: "example.com.", includeApexNS: true})
+	assert.NoError(t, err)
+	assert.Contains(t, rrsets, zoneFileRRSet{
+		name: "example.com.", recordType: "NS", ttl: 3600, records: []string{"ns1.selectel.org.", "ns2.selectel.org."},
+	})
+}
+
+func TestParseZoneFileWildcard(t *testing.T) {
+	rrsets, err := parseZoneFile("$TTL 60\n* A 192.0.2.1", zoneFileParseOpts{origin: "example.com."})
+	assert.NoError(t, err)
+	assert.Equal(t, []zoneFileRRSet{{name: "*.example.com.", recordType: "A", ttl: 60, records: []string{"192.0.2.1"}}}, rrsets)
+
+	_, err = parseZoneFile("$TTL 60\n@ MX 10 *.example.com.", zoneFileParseOpts{origin: "example.com."})
+	assert.ErrorContains(t, err, `host "*.example.com." is not a valid domain name`)
+}
+
+func TestParseZoneFileErrors(t *testing.T) {
+	testCases := map[string]string{
+		"www A 192.0.2.1":                              "zone file line 1: record has no TTL, set $TTL or the default TTL",
+		"$TTL 60\nwww.example.net. A 192.0.2.1":        "zone file line 2: www.example.net. is outside of the zone example.com.",
+		"$TTL 60\nwww PTR host":                        "zone file line 2: record type PTR is not supported",
+		"$TTL 60\nwww CH A 192.0.2.1":                  "zone file line 2: class CH is not supported",
+		"$INCLUDE other.zone":                          "zone file line 1: directive $INCLUDE is not supported",
+		"$TTL 60\nwww A 192.0.2.1\nwww 30 A 192.0.2.2": "zone file line 3: TTL 30 of www.example.com. A differs from TTL 60 of other records in the RRSet",
+		"$TTL 60\nwww TXT \"unterminated":              "zone file line 2: unterminated quoted string",
+		"$TTL 60\n@ SOA ns1. admin. (1 2 3 4 5":        "zone file line 2: unterminated (",
+		"   A 192.0.2.1":                               "zone file line 1: record has no owner name",
+		"$TTL 1x":                                      `zone file line 1: invalid TTL "1x"`,
+		"$TTL 60\nwww A 2001:db8::1":                   `zone file line 2: A record "2001:db8::1": must be an IPv4 address`,
+		"$TTL 60\nwww CNAME a\nwww CNAME b":            "zone file line 3: CNAME RRSet www.example.com. must contain exactly one record",
+	}
+
+	for zoneFile, expected := range testCases {
+		_, err := parseZoneFile(zoneFile, zoneFileParseOpts{origin: "example.com."})
+		assert.EqualError(t, err, expected, zoneFile)
+	}
+
+	rrsets, err := parseZoneFile("$TTL 60\nwww.example.net. A 192.0.2.1", zoneFileParseOpts{origin: "example.com.", anyZone: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []zoneFileRRSet{{name: "www.example.net.", recordType: "A", ttl: 60, records: []string{"192.0.2.1"}}}, rrsets)
+}
+
+func TestParseZoneFileTTL(t *testing.T) {
+	testCases := map[string]int{
+		"300":   300,
+		"5m":    300,
+		"1h30m": 5400,
+		"1D":    86400,
+		"1w2d":  777600,
+	}
+
+	for value, expected := range testCases {
+		ttl, err := parseZoneFileTTL(value)
+		assert.NoError(t, err, value)
+		assert.Equal(t, expected, ttl, value)
+	}
+}
+
+func TestRenderZoneFile(t *testing.T) {
+	rrsets := []*domainsV2.RRSet{
+		{Name: "www.example.com.", Type: domainsV2.CNAME, TTL: 300, Records: []domainsV2.RecordItem{{Content: "example.com."}}},
+		{Name: "example.com.", Type: domainsV2.A, TTL: 3600, Records: []domainsV2.RecordItem{{Content: "192.0.2.2"}, {Content: "192.0.2.1"}}},
+		{Name: "example.com.", Type: domainsV2.NS, TTL: 86400, Records: []domainsV2.RecordItem{{Content: "ns1.selectel.org."}}},
+		{Name: "example.com.", Type: domainsV2.SOA, TTL: 86400, Records: []domainsV2.RecordItem{{Content: "ns1.selectel.org. support.selectel.ru. 1 10800 3600 604800 60"}}},
+		{Name: "mail.example.com.", Type: domainsV2.MX, TTL: 3600, Records: []domainsV2.RecordItem{{Content: "10 mx.example.net.", Disabled: true}}},
+		{Name: "_sip._tcp.example.com.", Type: domainsV2.SRV, TTL: 3600, Records: []domainsV2.RecordItem{{Content: "10 60 5060 sip.example.com."}}},
+	}
+
+	expected := "$ORIGIN example.com.\n" +
+		"$TTL 3600\n" +
+		"@\t86400\tIN\tSOA\tns1.selectel.org. support.selectel.ru. 1 10800 3600 604800 60\n" +
+		"@\t86400\tIN\tNS\tns1.selectel.org.\n" +
+		"@\t\tIN\tA\t192.0.2.1\n" +
+		"@\t\tIN\tA\t192.0.2.2\n" +
+		"_sip._tcp\t\tIN\tSRV\t10 60 5060 sip.example.com.\n" +
+		"; mail\t\tIN\tMX\t10 mx.example.net.\n" +
+		"www\t300\tIN\tCNAME\texample.com.\n"
+
+	zoneFile := renderZoneFile("example.com", 3600, rrsets)
+	assert.Equal(t, expected, zoneFile)
+	assert.Equal(t, "example.com.", rrsets[1].Name, "input must not be reordered")
+
+	parsed, err := parseZoneFile(zoneFile, zoneFileParseOpts{origin: "example.com.", includeApexNS: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []zoneFileRRSet{
+		{name: "_sip._tcp.example.com.", recordType: "SRV", ttl: 3600, records: []string{"10 60 5060 sip.example.com."}},
+		{name: "example.com.", recordType: "A", ttl: 3600, records: []string{"192.0.2.1", "192.0.2.2"}},
+		{name: "example.com.", recordType: "NS", ttl: 86400, records: []string{"ns1.selectel.org."}},
+		{name: "www.example.com.", recordType: "CNAME", ttl: 300, records: []string{"example.com."}},
+	}, parsed)
+}
@@ -39,6 +39,9 @@ const (
 	objectGrant                     = "grant"
 	objectExtension                 = "extension"
 	objectDatastoreTypes            = "datastore-types"
+	objectDatastores                = "datastores"
+	objectDatabases                 = "databases"
+	objectUsers                     = "users"
 	objectAvailableExtensions       = "available-extensions"
 	objectFlavors                   = "flavors"
 	objectConfigurationParameters   = "configuration-parameters"
@@ -109,18 +112,28 @@ func Provider() *schema.Provider {
 			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"selectel_domains_domain_v1":                dataSourceDomainsDomainV1(),
-			"selectel_domains_zone_v2":                  dataSourceDomainsZoneV2(),
-			"selectel_domains_rrset_v2":                 dataSourceDomainsRRSetV2(),
-			"selectel_dbaas_datastore_type_v1":          dataSourceDBaaSDatastoreTypeV1(),
-			"selectel_dbaas_available_extension_v1":     dataSourceDBaaSAvailableExtensionV1(),
-			"selectel_dbaas_flavor_v1":                  dataSourceDBaaSFlavorV1(),
-			"selectel_dbaas_configuration_parameter_v1": dataSourceDBaaSConfigurationParameterV1(),
-			"selectel_dbaas_prometheus_metric_token_v1": dataSourceDBaaSPrometheusMetricTokenV1(),
-			"selectel_mks_kubeconfig_v1":                dataSourceMKSKubeconfigV1(),
-			"selectel_mks_kube_versions_v1":             dataSourceMKSKubeVersionsV1(),
-			"selectel_mks_feature_gates_v1":             dataSourceMKSFeatureGatesV1(),
-			"selectel_mks_admission_controllers_v1":     dataSourceMKSAdmissionControllersV1(),
+			"selectel_domains_domain_v1":                 dataSourceDomainsDomainV1(),
+			"selectel_domains_domain_rrsets_v1":          dataSourceDomainsDomainRRSetsV1(),
+			"selectel_domains_zone_v2":                   dataSourceDomainsZoneV2(),
+			"selectel_domains_zones_v2":                  dataSourceDomainsZonesV2(),
+			"selectel_domains_rrset_v2":                  dataSourceDomainsRRSetV2(),
+			"selectel_domains_rrsets_v2":                 dataSourceDomainsRRSetsV2(),
+			"selectel_domains_zone_file_v2":              dataSourceDomainsZoneFileV2(),
+			"selectel_dbaas_datastore_type_v1":           dataSourceDBaaSDatastoreTypeV1(),
+			"selectel_dbaas_available_extension_v1":      dataSourceDBaaSAvailableExtensionV1(),
+			"selectel_dbaas_flavor_v1":                   dataSourceDBaaSFlavorV1(),
+			"selectel_dbaas_configuration_parameter_v1":  dataSourceDBaaSConfigurationParameterV1(),
+			"selectel_dbaas_prometheus_metric_token_v1":  dataSourceDBaaSPrometheusMetricTokenV1(),
+			"selectel_dbaas_prometheus_scrape_config_v1": dataSourceDBaaSPrometheusScrapeConfigV1(),
+			"selectel_dbaas_datastore_v1":                dataSourceDBaaSDatastoreV1(),
+			"selectel_dbaas_datastores_v1":               dataSourceDBaaSDatastoresV1(),
+			"selectel_dbaas_databases_v1":                dataSourceDBaaSDatabasesV1(),
+			"selectel_dbaas_users_v1":                    dataSourceDBaaSUsersV1(),
+			"selectel_dbaas_datastore_connection_v1":     dataSourceDBaaSDatastoreConnectionV1(),
+			"selectel_mks_kubeconfig_v1":                 dataSourceMKSKubeconfigV1(),
+			"selectel_mks_kube_versions_v1":              dataSourceMKSKubeVersionsV1(),
+			"selectel_mks_feature_gates_v1":              dataSourceMKSFeatureGatesV1(),
+			"selectel_mks_admission_controllers_v1":      dataSourceMKSAdmissionControllersV1(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"selectel_vpc_floatingip_v2":                            resourceVPCFloatingIPV2(),
@@ -141,6 +154,9 @@ func Provider() *schema.Provider {
 			"selectel_domains_record_v1":                            resourceDomainsRecordV1(),
 			"selectel_domains_zone_v2":                              resourceDomainsZoneV2(),
 			"selectel_domains_rrset_v2":                             resourceDomainsRRSetV2(),
+			"selectel_domains_zone_records_v2":                      resourceDomainsZoneRecordsV2(),
+			"selectel_domains_zone_exclusive_rrsets_v2":             resourceDomainsZoneExclusiveRRSetsV2(),
+			"selectel_domains_zone_delegation_v2":                   resourceDomainsZoneDelegationV2(),
 			"selectel_dbaas_datastore_v1":                           resourceDBaaSDatastoreV1(), // DEPRECATED
 			"selectel_dbaas_postgresql_datastore_v1":                resourceDBaaSPostgreSQLDatastoreV1(),
 			"selectel_dbaas_mysql_datastore_v1":                     resourceDBaaSMySQLDatastoreV1(),
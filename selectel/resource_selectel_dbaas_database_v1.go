@@ -31,6 +31,8 @@ func resourceDBaaSDatabaseV1() *schema.Resource {
 			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 		Schema: resourceDBaaSPostgreSQLDatabaseV1Schema(),
+		DeprecationMessage: "selectel_dbaas_database_v1 is deprecated, use selectel_dbaas_postgresql_database_v1 " +
+			"or selectel_dbaas_mysql_database_v1 instead",
 	}
 }
 
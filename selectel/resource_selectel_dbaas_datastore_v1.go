@@ -27,6 +27,7 @@ func resourceDBaaSDatastoreV1() *schema.Resource {
 			StateContext: resourceDBaaSDatastoreV1ImportState,
 		},
 		CustomizeDiff: customdiff.All(
+			validateDatastoreFlavorChange,
 			refreshDatastoreInstancesOutputsDiff,
 		),
 		Timeouts: &schema.ResourceTimeout{
@@ -35,6 +36,8 @@ func resourceDBaaSDatastoreV1() *schema.Resource {
 			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 		Schema: resourceDBaaSDatastoreV1Schema(),
+		DeprecationMessage: "selectel_dbaas_datastore_v1 is deprecated, use selectel_dbaas_postgresql_datastore_v1, " +
+			"selectel_dbaas_mysql_datastore_v1 or selectel_dbaas_redis_datastore_v1 instead",
 	}
 }
 
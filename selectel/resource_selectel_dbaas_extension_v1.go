@@ -29,7 +29,8 @@ func resourceDBaaSExtensionV1() *schema.Resource {
 			Update: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
-		Schema: resourceDBaaSPostgreSQLExtensionV1Schema(),
+		Schema:             resourceDBaaSPostgreSQLExtensionV1Schema(),
+		DeprecationMessage: "selectel_dbaas_extension_v1 is deprecated, use selectel_dbaas_postgresql_extension_v1 instead",
 	}
 }
 
@@ -3,10 +3,14 @@ package selectel
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
+	"net/netip"
+	"sort"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/selectel/dbaas-go"
 	schemas "github.com/terraform-providers/terraform-provider-selectel/selectel/schemas/dbaas"
@@ -28,6 +32,9 @@ func resourceDBaaSFirewallV1() *schema.Resource {
 			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 		Schema: schemas.ResourceDBaaSFirewallV1Schema(),
+		CustomizeDiff: customdiff.All(
+			validateDBaaSFirewallV1IPs,
+		),
 	}
 }
 
@@ -39,7 +46,7 @@ func resourceDBaaSFirewallV1Update(ctx context.Context, d *schema.ResourceData,
 		return diagErr
 	}
 
-	rawIPs := d.Get("ips").([]interface{})
+	rawIPs := d.Get("ips").(*schema.Set).List()
 	firewallOpts, err := resourceDBaaSDatastoreV1FirewallOptsFromList(rawIPs)
 	if err != nil {
 		return diag.FromErr(errParseDatastoreV1Firewall(err))
@@ -157,8 +164,9 @@ func resourceDBaaSDatastoreV1FirewallOptsFromList(rawList []interface{}) (dbaas.
 
 	ipsList := make([]string, len(rawList))
 	for i := range rawList {
-		ipsList[i] = rawList[i].(string)
+		ipsList[i] = schemas.CanonicalDBaaSFirewallIP(rawList[i].(string))
 	}
+	sort.Strings(ipsList)
 
 	var firewall dbaas.DatastoreFirewallOpts
 	firewall.IPs = append(firewall.IPs, ipsList...)
@@ -166,6 +174,52 @@ func resourceDBaaSDatastoreV1FirewallOptsFromList(rawList []interface{}) (dbaas.
 	return firewall, nil
 }
 
+// validateDBaaSFirewallV1IPs checks the configured firewall entries for duplicates
+// and overlapping networks. Entries that are not known yet are skipped.
+func validateDBaaSFirewallV1IPs(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	rawIPs := d.GetRawConfig().GetAttr("ips")
+	if rawIPs.IsNull() || !rawIPs.IsKnown() {
+		return nil
+	}
+
+	var ips []string
+	for _, rawIP := range rawIPs.AsValueSlice() {
+		if rawIP.IsNull() || !rawIP.IsKnown() {
+			continue
+		}
+		ips = append(ips, rawIP.AsString())
+	}
+
+	return checkDBaaSFirewallIPsOverlap(ips)
+}
+
+func checkDBaaSFirewallIPsOverlap(ips []string) error {
+	prefixes := make([]netip.Prefix, 0, len(ips))
+	for _, ip := range ips {
+		prefix, err := schemas.ParseDBaaSFirewallIP(ip)
+		if err != nil {
+			return err
+		}
+		prefixes = append(prefixes, prefix)
+	}
+
+	for i := range prefixes {
+		for j := i + 1; j < len(prefixes); j++ {
+			a, b := prefixes[i], prefixes[j]
+			switch {
+			case a == b:
+				return fmt.Errorf("ips contains duplicate entries %q and %q", ips[i], ips[j])
+			case a.Overlaps(b) && a.Bits() <= b.Bits():
+				return fmt.Errorf("ips entry %q is redundant, it is already covered by %q", ips[j], ips[i])
+			case a.Overlaps(b):
+				return fmt.Errorf("ips entry %q is redundant, it is already covered by %q", ips[i], ips[j])
+			}
+		}
+	}
+
+	return nil
+}
+
 func firewallChecksum(firewall []dbaas.Firewall, datastoreID string) (string, error) {
 	ipsList := make([]string, len(firewall))
 	for _, rule := range firewall {
@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/selectel/go-selvpcclient/v4/selvpcclient/resell/v2/projects"
+	"github.com/stretchr/testify/assert"
+	schemas "github.com/terraform-providers/terraform-provider-selectel/selectel/schemas/dbaas"
 )
 
 func TestAccDBaaSFirewallV1Basic(t *testing.T) {
@@ -33,6 +35,58 @@ func TestAccDBaaSFirewallV1Basic(t *testing.T) {
 	})
 }
 
+func TestCanonicalDBaaSFirewallIP(t *testing.T) {
+	testCases := map[string]string{
+		"10.0.0.1":          "10.0.0.1",
+		" 10.0.0.1 ":        "10.0.0.1",
+		"10.0.0.1/32":       "10.0.0.1",
+		"10.0.0.0/24":       "10.0.0.0/24",
+		"2001:0db8::0001":   "2001:db8::1",
+		"2001:db8::/32":     "2001:db8::/32",
+		"2001:db8::1/128":   "2001:db8::1",
+		"::ffff:10.0.0.1":   "10.0.0.1",
+		"10.0.0.0/33":       "10.0.0.0/33",
+		"10.0.0.5/24":       "10.0.0.5/24",
+		"not-an-ip-address": "not-an-ip-address",
+	}
+
+	for value, expected := range testCases {
+		assert.Equal(t, expected, schemas.CanonicalDBaaSFirewallIP(value), value)
+	}
+}
+
+func TestValidateDBaaSFirewallIP(t *testing.T) {
+	for _, value := range []string{"10.0.0.1", "10.0.0.0/24", "0.0.0.0/0", "2001:db8::/32"} {
+		_, errs := schemas.ValidateDBaaSFirewallIP(value, "ips")
+		assert.Empty(t, errs, value)
+	}
+
+	for _, value := range []string{"10.0.0.0/33", "10.0.0.5/24", "10.0.0.256", "fe80::1%eth0", ""} {
+		_, errs := schemas.ValidateDBaaSFirewallIP(value, "ips")
+		assert.NotEmpty(t, errs, value)
+	}
+}
+
+func TestCheckDBaaSFirewallIPsOverlap(t *testing.T) {
+	assert.NoError(t, checkDBaaSFirewallIPsOverlap([]string{"10.0.0.1", "10.0.0.2", "10.0.1.0/24"}))
+	assert.NoError(t, checkDBaaSFirewallIPsOverlap(nil))
+
+	err := checkDBaaSFirewallIPsOverlap([]string{"10.0.0.1", "10.0.0.1/32"})
+	assert.EqualError(t, err, `ips contains duplicate entries "10.0.0.1" and "10.0.0.1/32"`)
+
+	err = checkDBaaSFirewallIPsOverlap([]string{"10.0.0.0/16", "10.0.1.0/24"})
+	assert.EqualError(t, err, `ips entry "10.0.1.0/24" is redundant, it is already covered by "10.0.0.0/16"`)
+
+	err = checkDBaaSFirewallIPsOverlap([]string{"10.0.1.5", "10.0.0.0/16"})
+	assert.EqualError(t, err, `ips entry "10.0.1.5" is redundant, it is already covered by "10.0.0.0/16"`)
+}
+
+func TestResourceDBaaSDatastoreV1FirewallOptsFromList(t *testing.T) {
+	opts, err := resourceDBaaSDatastoreV1FirewallOptsFromList([]interface{}{"10.0.1.0/24", "10.0.0.1/32"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.1", "10.0.1.0/24"}, opts.IPs)
+}
+
 func testAccDBaaSFirewallV1Basic(projectName, datastoreName string, nodeCount int) string {
 	return fmt.Sprintf(`
 resource "selectel_vpc_project_v2" "project_tf_acc_test_1" {
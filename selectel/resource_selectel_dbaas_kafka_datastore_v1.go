@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/selectel/dbaas-go"
@@ -25,6 +26,9 @@ func resourceDBaaSKafkaDatastoreV1() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceDBaaSKafkaDatastoreV1ImportState,
 		},
+		CustomizeDiff: customdiff.All(
+			validateDatastoreFlavorChange,
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Update: schema.DefaultTimeout(60 * time.Minute),
@@ -214,7 +218,7 @@ func resourceDBaaSKafkaDatastoreV1Delete(ctx context.Context, d *schema.Resource
 	return nil
 }
 
-func resourceDBaaSKafkaDatastoreV1ImportState(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceDBaaSKafkaDatastoreV1ImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	config := meta.(*Config)
 	if config.ProjectID == "" {
 		return nil, errors.New("INFRA_PROJECT_ID must be set for the resource import")
@@ -226,5 +230,9 @@ func resourceDBaaSKafkaDatastoreV1ImportState(_ context.Context, d *schema.Resou
 	d.Set("project_id", config.ProjectID)
 	d.Set("region", config.Region)
 
+	if err := validateImportedDatastoreType(ctx, d, meta, d.Id(), []string{kafkaDatastoreType}); err != nil {
+		return nil, err
+	}
+
 	return []*schema.ResourceData{d}, nil
 }
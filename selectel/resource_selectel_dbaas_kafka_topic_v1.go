@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/selectel/dbaas-go"
@@ -25,6 +26,9 @@ func resourceDBaaSKafkaTopicV1() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceDBaaSTopicV1ImportState,
 		},
+		CustomizeDiff: customdiff.All(
+			customdiff.ValidateChange("partitions", validateDBaaSTopicV1PartitionsChange),
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Update: schema.DefaultTimeout(60 * time.Minute),
@@ -142,6 +146,20 @@ func resourceDBaaSTopicV1Delete(ctx context.Context, d *schema.ResourceData, met
 	return nil
 }
 
+// validateDBaaSTopicV1PartitionsChange rejects plans that decrease the number
+// of partitions, because Kafka can only add partitions to an existing topic.
+func validateDBaaSTopicV1PartitionsChange(_ context.Context, oldValue, newValue, _ interface{}) error {
+	oldPartitions, newPartitions := oldValue.(int), newValue.(int)
+	if oldPartitions == 0 || newPartitions >= oldPartitions {
+		return nil
+	}
+
+	return fmt.Errorf(
+		"partitions can't be decreased from %d to %d, the number of partitions can only be increased",
+		oldPartitions, newPartitions,
+	)
+}
+
 func resourceDBaaSTopicV1ImportState(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	config := meta.(*Config)
 	if config.ProjectID == "" {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/selectel/dbaas-go"
 	"github.com/selectel/go-selvpcclient/v4/selvpcclient/resell/v2/projects"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccDBaaSKafkaTopicV1Basic(t *testing.T) {
@@ -169,3 +170,12 @@ resource "selectel_dbaas_kafka_topic_v1" "topic_tf_acc_test_1" {
   partitions = "%s"
 }`, projectName, datastoreName, nodeCount, topicName, topicPartitions)
 }
+
+func TestValidateDBaaSTopicV1PartitionsChange(t *testing.T) {
+	ctx := context.Background()
+
+	assert.NoError(t, validateDBaaSTopicV1PartitionsChange(ctx, 0, 3, nil))
+	assert.NoError(t, validateDBaaSTopicV1PartitionsChange(ctx, 3, 3, nil))
+	assert.NoError(t, validateDBaaSTopicV1PartitionsChange(ctx, 3, 5, nil))
+	assert.Error(t, validateDBaaSTopicV1PartitionsChange(ctx, 5, 3, nil))
+}
@@ -110,7 +110,7 @@ func resourceDBaaSMySQLDatabaseV1Delete(ctx context.Context, d *schema.ResourceD
 	return nil
 }
 
-func resourceDBaaSMySQLDatabaseV1ImportState(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceDBaaSMySQLDatabaseV1ImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	config := meta.(*Config)
 	if config.ProjectID == "" {
 		return nil, errors.New("INFRA_PROJECT_ID must be set for the resource import")
@@ -122,5 +122,9 @@ func resourceDBaaSMySQLDatabaseV1ImportState(_ context.Context, d *schema.Resour
 	d.Set("project_id", config.ProjectID)
 	d.Set("region", config.Region)
 
+	if err := validateImportedDatabaseType(ctx, d, meta, []string{mySQLDatastoreType, mySQLNativeDatastoreType}); err != nil {
+		return nil, err
+	}
+
 	return []*schema.ResourceData{d}, nil
 }
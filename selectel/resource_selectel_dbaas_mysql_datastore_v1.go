@@ -27,6 +27,7 @@ func resourceDBaaSMySQLDatastoreV1() *schema.Resource {
 			StateContext: resourceDBaaSMySQLDatastoreV1ImportState,
 		},
 		CustomizeDiff: customdiff.All(
+			validateDatastoreFlavorChange,
 			refreshDatastoreInstancesOutputsDiff,
 		),
 		Timeouts: &schema.ResourceTimeout{
@@ -249,7 +250,7 @@ func resourceDBaaSMySQLDatastoreV1Delete(ctx context.Context, d *schema.Resource
 	return nil
 }
 
-func resourceDBaaSMySQLDatastoreV1ImportState(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceDBaaSMySQLDatastoreV1ImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	config := meta.(*Config)
 	if config.ProjectID == "" {
 		return nil, errors.New("INFRA_PROJECT_ID must be set for the resource import")
@@ -261,5 +262,9 @@ func resourceDBaaSMySQLDatastoreV1ImportState(_ context.Context, d *schema.Resou
 	d.Set("project_id", config.ProjectID)
 	d.Set("region", config.Region)
 
+	if err := validateImportedDatastoreType(ctx, d, meta, d.Id(), []string{mySQLDatastoreType, mySQLNativeDatastoreType}); err != nil {
+		return nil, err
+	}
+
 	return []*schema.ResourceData{d}, nil
 }
@@ -151,7 +151,7 @@ func resourceDBaaSPostgreSQLDatabaseV1Delete(ctx context.Context, d *schema.Reso
 	return nil
 }
 
-func resourceDBaaSPostgreSQLDatabaseV1ImportState(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceDBaaSPostgreSQLDatabaseV1ImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	config := meta.(*Config)
 	if config.ProjectID == "" {
 		return nil, errors.New("INFRA_PROJECT_ID must be set for the resource import")
@@ -163,5 +163,9 @@ func resourceDBaaSPostgreSQLDatabaseV1ImportState(_ context.Context, d *schema.R
 	d.Set("project_id", config.ProjectID)
 	d.Set("region", config.Region)
 
+	if err := validateImportedDatabaseType(ctx, d, meta, []string{postgreSQLDatastoreType}); err != nil {
+		return nil, err
+	}
+
 	return []*schema.ResourceData{d}, nil
 }
@@ -27,6 +27,7 @@ func resourceDBaaSPostgreSQLDatastoreV1() *schema.Resource {
 			StateContext: resourceDBaaSPostgreSQLDatastoreV1ImportState,
 		},
 		CustomizeDiff: customdiff.All(
+			validateDatastoreFlavorChange,
 			refreshDatastoreInstancesOutputsDiff,
 		),
 		Timeouts: &schema.ResourceTimeout{
@@ -263,7 +264,7 @@ func resourceDBaaSPostgreSQLDatastoreV1Delete(ctx context.Context, d *schema.Res
 	return nil
 }
 
-func resourceDBaaSPostgreSQLDatastoreV1ImportState(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceDBaaSPostgreSQLDatastoreV1ImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	config := meta.(*Config)
 	if config.ProjectID == "" {
 		return nil, errors.New("INFRA_PROJECT_ID must be set for the resource import")
@@ -275,5 +276,9 @@ func resourceDBaaSPostgreSQLDatastoreV1ImportState(_ context.Context, d *schema.
 	d.Set("project_id", config.ProjectID)
 	d.Set("region", config.Region)
 
+	if err := validateImportedDatastoreType(ctx, d, meta, d.Id(), []string{postgreSQLDatastoreType}); err != nil {
+		return nil, err
+	}
+
 	return []*schema.ResourceData{d}, nil
 }
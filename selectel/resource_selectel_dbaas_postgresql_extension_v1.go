@@ -7,6 +7,7 @@ import (
 	"log"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -17,9 +18,23 @@ import (
 )
 
 func resourceDBaaSPostgreSQLExtensionV1() *schema.Resource {
+	extensionSchema := resourceDBaaSPostgreSQLExtensionV1Schema()
+	extensionSchema["install_dependencies"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+	}
+	extensionSchema["dependency_extension_ids"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
 	return &schema.Resource{
 		CreateContext: resourceDBaaSPostgreSQLExtensionV1Create,
 		ReadContext:   resourceDBaaSPostgreSQLExtensionV1Read,
+		UpdateContext: resourceDBaaSPostgreSQLExtensionV1Update,
 		DeleteContext: resourceDBaaSPostgreSQLExtensionV1Delete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceDBaaSPostgreSQLExtensionV1ImportState,
@@ -29,7 +44,7 @@ func resourceDBaaSPostgreSQLExtensionV1() *schema.Resource {
 			Update: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
-		Schema: resourceDBaaSPostgreSQLExtensionV1Schema(),
+		Schema: extensionSchema,
 	}
 }
 
@@ -39,21 +54,45 @@ func resourceDBaaSPostgreSQLExtensionV1Create(ctx context.Context, d *schema.Res
 		return diagErr
 	}
 
-	extensionCreateOpts := dbaas.ExtensionCreateOpts{
-		AvailableExtensionID: d.Get("available_extension_id").(string),
-		DatastoreID:          d.Get("datastore_id").(string),
-		DatabaseID:           d.Get("database_id").(string),
+	availableExtensionID := d.Get("available_extension_id").(string)
+	datastoreID := d.Get("datastore_id").(string)
+	databaseID := d.Get("database_id").(string)
+	timeout := d.Timeout(schema.TimeoutCreate)
+
+	// Without install_dependencies the extension is created as is, like before the
+	// dependencies were resolved by the provider.
+	var missingDependencies []dbaas.AvailableExtension
+	if !d.GetRawConfig().GetAttr("install_dependencies").IsNull() {
+		dependencies, installedExtensions, err := getDBaaSExtensionDependencies(ctx, dbaasClient, availableExtensionID, databaseID)
+		if err != nil {
+			return diag.FromErr(errCreatingObject(objectExtension, err))
+		}
+		missingDependencies = filterMissingDBaaSExtensions(dependencies, installedExtensions)
+	}
+	if len(missingDependencies) > 0 && !d.Get("install_dependencies").(bool) {
+		return diag.FromErr(errCreatingObject(objectExtension, fmt.Errorf(
+			"extension %s requires extensions that aren't installed in the database %s: %s, "+
+				"install them first or set install_dependencies to true",
+			availableExtensionID, databaseID, strings.Join(availableExtensionNames(missingDependencies), ", "),
+		)))
 	}
 
-	log.Print(msgCreate(objectExtension, extensionCreateOpts))
-	extension, err := dbaasClient.CreateExtension(ctx, extensionCreateOpts)
-	if err != nil {
-		return diag.FromErr(errCreatingObject(objectExtension, err))
+	for _, dependency := range missingDependencies {
+		_, err := ensureDBaaSExtension(ctx, dbaasClient, dbaas.ExtensionCreateOpts{
+			AvailableExtensionID: dependency.ID,
+			DatastoreID:          datastoreID,
+			DatabaseID:           databaseID,
+		}, timeout)
+		if err != nil {
+			return diag.FromErr(errCreatingObject(objectExtension, err))
+		}
 	}
 
-	log.Printf("[DEBUG] waiting for extension %s to become 'ACTIVE'", extension.ID)
-	timeout := d.Timeout(schema.TimeoutCreate)
-	err = waiters.WaitForDBaaSExtensionV1ActiveState(ctx, dbaasClient, extension.ID, timeout)
+	extension, err := createDBaaSExtension(ctx, dbaasClient, dbaas.ExtensionCreateOpts{
+		AvailableExtensionID: availableExtensionID,
+		DatastoreID:          datastoreID,
+		DatabaseID:           databaseID,
+	}, timeout)
 	if err != nil {
 		return diag.FromErr(errCreatingObject(objectExtension, err))
 	}
@@ -79,9 +118,21 @@ func resourceDBaaSPostgreSQLExtensionV1Read(ctx context.Context, d *schema.Resou
 	d.Set("datastore_id", extension.DatastoreID)
 	d.Set("database_id", extension.DatabaseID)
 
+	dependencies, installedExtensions, err := getDBaaSExtensionDependencies(ctx, dbaasClient, extension.AvailableExtensionID, extension.DatabaseID)
+	if err != nil {
+		return diag.FromErr(errGettingObject(objectExtension, d.Id(), err))
+	}
+	d.Set("dependency_extension_ids", installedDBaaSExtensionDependencyIDs(dependencies, installedExtensions))
+
 	return nil
 }
 
+// resourceDBaaSPostgreSQLExtensionV1Update only stores install_dependencies,
+// which is used when the extension is created.
+func resourceDBaaSPostgreSQLExtensionV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceDBaaSPostgreSQLExtensionV1Read(ctx, d, meta)
+}
+
 func resourceDBaaSPostgreSQLExtensionV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	dbaasClient, diagErr := getDBaaSClient(d, meta)
 	if diagErr != nil {
@@ -112,7 +163,7 @@ func resourceDBaaSPostgreSQLExtensionV1Delete(ctx context.Context, d *schema.Res
 	return nil
 }
 
-func resourceDBaaSPostgreSQLExtensionV1ImportState(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceDBaaSPostgreSQLExtensionV1ImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	config := meta.(*Config)
 	if config.ProjectID == "" {
 		return nil, errors.New("INFRA_PROJECT_ID must be set for the resource import")
@@ -123,6 +174,180 @@ func resourceDBaaSPostgreSQLExtensionV1ImportState(_ context.Context, d *schema.
 
 	d.Set("project_id", config.ProjectID)
 	d.Set("region", config.Region)
+	if err := validateImportedExtensionType(ctx, d, meta, []string{postgreSQLDatastoreType}); err != nil {
+		return nil, err
+	}
 
 	return []*schema.ResourceData{d}, nil
 }
+
+func createDBaaSExtension(ctx context.Context, client *dbaas.API, opts dbaas.ExtensionCreateOpts, timeout time.Duration) (dbaas.Extension, error) {
+	log.Print(msgCreate(objectExtension, opts))
+	extension, err := client.CreateExtension(ctx, opts)
+	if err != nil {
+		return dbaas.Extension{}, err
+	}
+
+	log.Printf("[DEBUG] waiting for extension %s to become 'ACTIVE'", extension.ID)
+	err = waiters.WaitForDBaaSExtensionV1ActiveState(ctx, client, extension.ID, timeout)
+	if err != nil {
+		return dbaas.Extension{}, err
+	}
+
+	return extension, nil
+}
+
+// ensureDBaaSExtension installs an extension unless it is already installed in the
+// database. Dependencies can be shared by several extension resources that are
+// created in parallel, so an extension that appeared after the create request
+// failed is used instead of returning the error.
+func ensureDBaaSExtension(ctx context.Context, client *dbaas.API, opts dbaas.ExtensionCreateOpts, timeout time.Duration) (dbaas.Extension, error) {
+	extension, createErr := createDBaaSExtension(ctx, client, opts, timeout)
+	if createErr == nil {
+		return extension, nil
+	}
+
+	log.Print(msgGet(objectExtension, opts.DatabaseID))
+	installedExtensions, err := client.Extensions(ctx, &dbaas.ExtensionQueryParams{
+		DatabaseID:           opts.DatabaseID,
+		AvailableExtensionID: opts.AvailableExtensionID,
+	})
+	if err != nil {
+		return dbaas.Extension{}, createErr
+	}
+	extension, ok := findDBaaSExtension(installedExtensions, opts.AvailableExtensionID)
+	if !ok {
+		return dbaas.Extension{}, createErr
+	}
+
+	log.Printf("[DEBUG] available extension %s is already installed as extension %s, waiting for it to become 'ACTIVE'",
+		opts.AvailableExtensionID, extension.ID)
+	err = waiters.WaitForDBaaSExtensionV1ActiveState(ctx, client, extension.ID, timeout)
+	if err != nil {
+		return dbaas.Extension{}, err
+	}
+
+	return extension, nil
+}
+
+func findDBaaSExtension(extensions []dbaas.Extension, availableExtensionID string) (dbaas.Extension, bool) {
+	for _, extension := range extensions {
+		if extension.AvailableExtensionID == availableExtensionID {
+			return extension, true
+		}
+	}
+
+	return dbaas.Extension{}, false
+}
+
+// getDBaaSExtensionDependencies returns dependencies of the available extension in
+// installation order and the extensions installed in the database.
+func getDBaaSExtensionDependencies(ctx context.Context, client *dbaas.API, availableExtensionID, databaseID string) ([]dbaas.AvailableExtension, []dbaas.Extension, error) {
+	log.Print(msgGet(objectAvailableExtensions, availableExtensionID))
+	availableExtensions, err := client.AvailableExtensions(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dependencies, err := resolveDBaaSExtensionDependencies(availableExtensions, availableExtensionID)
+	if err != nil || len(dependencies) == 0 {
+		return nil, nil, err
+	}
+
+	log.Print(msgGet(objectExtension, databaseID))
+	installedExtensions, err := client.Extensions(ctx, &dbaas.ExtensionQueryParams{DatabaseID: databaseID})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return dependencies, installedExtensions, nil
+}
+
+// resolveDBaaSExtensionDependencies returns all direct and transitive dependencies of
+// the available extension so that every extension comes after its own dependencies.
+func resolveDBaaSExtensionDependencies(availableExtensions []dbaas.AvailableExtension, availableExtensionID string) ([]dbaas.AvailableExtension, error) {
+	extensionsByID := make(map[string]dbaas.AvailableExtension, len(availableExtensions))
+	for _, availableExtension := range availableExtensions {
+		extensionsByID[availableExtension.ID] = availableExtension
+	}
+
+	const (
+		visiting = iota + 1
+		visited
+	)
+	states := map[string]int{}
+	var dependencies []dbaas.AvailableExtension
+
+	var visit func(id string) error
+	visit = func(id string) error {
+		switch states[id] {
+		case visiting:
+			return fmt.Errorf("available extension %s has a circular dependency", id)
+		case visited:
+			return nil
+		}
+
+		availableExtension, ok := extensionsByID[id]
+		if !ok {
+			return fmt.Errorf("available extension %s not found", id)
+		}
+
+		states[id] = visiting
+		for _, dependencyID := range availableExtension.DependencyIDs {
+			if err := visit(dependencyID); err != nil {
+				return err
+			}
+		}
+		states[id] = visited
+
+		if id != availableExtensionID {
+			dependencies = append(dependencies, availableExtension)
+		}
+
+		return nil
+	}
+
+	if err := visit(availableExtensionID); err != nil {
+		return nil, err
+	}
+
+	return dependencies, nil
+}
+
+func filterMissingDBaaSExtensions(dependencies []dbaas.AvailableExtension, installedExtensions []dbaas.Extension) []dbaas.AvailableExtension {
+	installed := make(map[string]struct{}, len(installedExtensions))
+	for _, extension := range installedExtensions {
+		installed[extension.AvailableExtensionID] = struct{}{}
+	}
+
+	var missing []dbaas.AvailableExtension
+	for _, dependency := range dependencies {
+		if _, ok := installed[dependency.ID]; !ok {
+			missing = append(missing, dependency)
+		}
+	}
+
+	return missing
+}
+
+// installedDBaaSExtensionDependencyIDs returns IDs of the installed extensions for
+// the dependencies, in installation order.
+func installedDBaaSExtensionDependencyIDs(dependencies []dbaas.AvailableExtension, installedExtensions []dbaas.Extension) []string {
+	ids := make([]string, 0, len(dependencies))
+	for _, dependency := range dependencies {
+		if extension, ok := findDBaaSExtension(installedExtensions, dependency.ID); ok {
+			ids = append(ids, extension.ID)
+		}
+	}
+
+	return ids
+}
+
+func availableExtensionNames(availableExtensions []dbaas.AvailableExtension) []string {
+	names := make([]string, len(availableExtensions))
+	for i, availableExtension := range availableExtensions {
+		names[i] = availableExtension.Name
+	}
+
+	return names
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/selectel/dbaas-go"
 	"github.com/selectel/go-selvpcclient/v4/selvpcclient/resell/v2/projects"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccDBaaSPostgreSQLExtensionV1Basic(t *testing.T) {
@@ -110,3 +111,78 @@ resource "selectel_dbaas_postgresql_extension_v1" "extension_tf_acc_test_1" {
   database_id = "${selectel_dbaas_postgresql_database_v1.database_tf_acc_test_1.id}"
 }`, projectName, datastoreName, nodeCount, userName, userPassword, databaseName, extensionName)
 }
+
+func TestResolveDBaaSExtensionDependencies(t *testing.T) {
+	availableExtensions := []dbaas.AvailableExtension{
+		{ID: "postgis", Name: "postgis"},
+		{ID: "postgis_topology", Name: "postgis_topology", DependencyIDs: []string{"postgis"}},
+		{ID: "postgis_tiger_geocoder", Name: "postgis_tiger_geocoder", DependencyIDs: []string{"fuzzystrmatch", "postgis"}},
+		{ID: "fuzzystrmatch", Name: "fuzzystrmatch"},
+		{ID: "address_standardizer", Name: "address_standardizer", DependencyIDs: []string{"postgis_tiger_geocoder"}},
+	}
+
+	dependencies, err := resolveDBaaSExtensionDependencies(availableExtensions, "postgis")
+	assert.NoError(t, err)
+	assert.Empty(t, dependencies)
+
+	dependencies, err = resolveDBaaSExtensionDependencies(availableExtensions, "postgis_topology")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"postgis"}, availableExtensionNames(dependencies))
+
+	dependencies, err = resolveDBaaSExtensionDependencies(availableExtensions, "address_standardizer")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"fuzzystrmatch", "postgis", "postgis_tiger_geocoder"}, availableExtensionNames(dependencies))
+
+	_, err = resolveDBaaSExtensionDependencies(availableExtensions, "unknown")
+	assert.EqualError(t, err, "available extension unknown not found")
+
+	_, err = resolveDBaaSExtensionDependencies([]dbaas.AvailableExtension{
+		{ID: "a", DependencyIDs: []string{"b"}},
+		{ID: "b", DependencyIDs: []string{"a"}},
+	}, "a")
+	assert.EqualError(t, err, "available extension a has a circular dependency")
+}
+
+func TestFilterMissingDBaaSExtensions(t *testing.T) {
+	dependencies := []dbaas.AvailableExtension{
+		{ID: "fuzzystrmatch", Name: "fuzzystrmatch"},
+		{ID: "postgis", Name: "postgis"},
+	}
+	installed := []dbaas.Extension{{ID: "extension-1", AvailableExtensionID: "postgis"}}
+
+	missing := filterMissingDBaaSExtensions(dependencies, installed)
+	assert.Equal(t, []string{"fuzzystrmatch"}, availableExtensionNames(missing))
+	assert.Empty(t, filterMissingDBaaSExtensions(dependencies, []dbaas.Extension{
+		{AvailableExtensionID: "postgis"},
+		{AvailableExtensionID: "fuzzystrmatch"},
+	}))
+}
+
+func TestFindDBaaSExtension(t *testing.T) {
+	extensions := []dbaas.Extension{
+		{ID: "extension-1", AvailableExtensionID: "postgis"},
+		{ID: "extension-2", AvailableExtensionID: "fuzzystrmatch"},
+	}
+
+	extension, ok := findDBaaSExtension(extensions, "fuzzystrmatch")
+	assert.True(t, ok)
+	assert.Equal(t, "extension-2", extension.ID)
+
+	_, ok = findDBaaSExtension(extensions, "postgis_topology")
+	assert.False(t, ok)
+}
+
+func TestInstalledDBaaSExtensionDependencyIDs(t *testing.T) {
+	dependencies := []dbaas.AvailableExtension{
+		{ID: "fuzzystrmatch", Name: "fuzzystrmatch"},
+		{ID: "postgis", Name: "postgis"},
+	}
+	installed := []dbaas.Extension{
+		{ID: "extension-2", AvailableExtensionID: "postgis"},
+		{ID: "extension-3", AvailableExtensionID: "pg_trgm"},
+		{ID: "extension-1", AvailableExtensionID: "fuzzystrmatch"},
+	}
+
+	assert.Equal(t, []string{"extension-1", "extension-2"}, installedDBaaSExtensionDependencyIDs(dependencies, installed))
+	assert.Empty(t, installedDBaaSExtensionDependencyIDs(dependencies, nil))
+}
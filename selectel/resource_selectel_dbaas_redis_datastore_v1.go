@@ -28,6 +28,7 @@ func resourceDBaaSRedisDatastoreV1() *schema.Resource {
 		},
 		CustomizeDiff: customdiff.All(
 			refreshDatastoreInstancesOutputsDiff,
+			refreshDBaaSGeneratedPasswordDiff("redis_password", "redis_password_version"),
 		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -79,10 +80,11 @@ func resourceDBaaSRedisDatastoreV1Create(ctx context.Context, d *schema.Resource
 		datastoreCreateOpts.FlavorID = flavorID.(string)
 	}
 
-	redisPassword, redisPasswordOk := d.GetOk("redis_password")
-	if redisPasswordOk {
-		datastoreCreateOpts.RedisPassword = redisPassword.(string)
+	redisPassword, err := dbaasPasswordFromConfig(d, "redis_password")
+	if err != nil {
+		return diag.FromErr(errCreatingObject(objectDatastore, err))
 	}
+	datastoreCreateOpts.RedisPassword = redisPassword
 
 	backupRetentionDays, ok := d.GetOk("backup_retention_days")
 	if ok {
@@ -108,6 +110,7 @@ func resourceDBaaSRedisDatastoreV1Create(ctx context.Context, d *schema.Resource
 	}
 
 	d.SetId(datastore.ID)
+	d.Set("redis_password", redisPassword)
 
 	return resourceDBaaSRedisDatastoreV1Read(ctx, d, meta)
 }
@@ -189,7 +192,7 @@ func resourceDBaaSRedisDatastoreV1Update(ctx context.Context, d *schema.Resource
 			return diag.FromErr(err)
 		}
 	}
-	if d.HasChange("redis_password") {
+	if d.HasChanges("redis_password", "redis_password_version") {
 		err := updateRedisDatastorePassword(ctx, d, dbaasClient)
 		if err != nil {
 			return diag.FromErr(err)
@@ -246,7 +249,7 @@ func resourceDBaaSRedisDatastoreV1Delete(ctx context.Context, d *schema.Resource
 	return nil
 }
 
-func resourceDBaaSRedisDatastoreV1ImportState(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceDBaaSRedisDatastoreV1ImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	config := meta.(*Config)
 	if config.ProjectID == "" {
 		return nil, errors.New("INFRA_PROJECT_ID must be set for the resource import")
@@ -258,5 +261,9 @@ func resourceDBaaSRedisDatastoreV1ImportState(_ context.Context, d *schema.Resou
 	d.Set("project_id", config.ProjectID)
 	d.Set("region", config.Region)
 
+	if err := validateImportedDatastoreType(ctx, d, meta, d.Id(), []string{redisDatastoreType}); err != nil {
+		return nil, err
+	}
+
 	return []*schema.ResourceData{d}, nil
 }
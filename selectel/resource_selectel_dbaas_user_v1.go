@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/selectel/dbaas-go"
@@ -25,6 +26,9 @@ func resourceDBaaSUserV1() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceDBaaSUserV1ImportState,
 		},
+		CustomizeDiff: customdiff.All(
+			refreshDBaaSGeneratedPasswordDiff("password", "password_version"),
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Update: schema.DefaultTimeout(60 * time.Minute),
@@ -40,10 +44,15 @@ func resourceDBaaSUserV1Create(ctx context.Context, d *schema.ResourceData, meta
 		return diagErr
 	}
 
+	password, err := dbaasPasswordFromConfig(d, "password")
+	if err != nil {
+		return diag.FromErr(errCreatingObject(objectUser, err))
+	}
+
 	userCreateOpts := dbaas.UserCreateOpts{
 		DatastoreID: d.Get("datastore_id").(string),
 		Name:        d.Get("name").(string),
-		Password:    d.Get("password").(string),
+		Password:    password,
 	}
 
 	log.Print(msgCreate(objectUser, userCreateOpts))
@@ -60,6 +69,7 @@ func resourceDBaaSUserV1Create(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	d.SetId(user.ID)
+	d.Set("password", password)
 
 	return resourceDBaaSUserV1Read(ctx, d, meta)
 }
@@ -88,13 +98,17 @@ func resourceDBaaSUserV1Update(ctx context.Context, d *schema.ResourceData, meta
 		return diagErr
 	}
 
-	if d.HasChange("password") {
+	if d.HasChanges("password", "password_version") {
+		password, err := dbaasPasswordFromConfig(d, "password")
+		if err != nil {
+			return diag.FromErr(errUpdatingObject(objectUser, d.Id(), err))
+		}
 		updateOpts := dbaas.UserUpdateOpts{
-			Password: d.Get("password").(string),
+			Password: password,
 		}
 
 		log.Print(msgUpdate(objectUser, d.Id(), updateOpts))
-		_, err := dbaasClient.UpdateUser(ctx, d.Id(), updateOpts)
+		_, err = dbaasClient.UpdateUser(ctx, d.Id(), updateOpts)
 		if err != nil {
 			return diag.FromErr(errUpdatingObject(objectUser, d.Id(), err))
 		}
@@ -105,6 +119,7 @@ func resourceDBaaSUserV1Update(ctx context.Context, d *schema.ResourceData, meta
 		if err != nil {
 			return diag.FromErr(errUpdatingObject(objectUser, d.Id(), err))
 		}
+		d.Set("password", password)
 	}
 
 	return resourceDBaaSUserV1Read(ctx, d, meta)
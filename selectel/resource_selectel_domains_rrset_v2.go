@@ -8,7 +8,9 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	domainsV2 "github.com/selectel/domains-go/pkg/v2"
 )
 
@@ -23,6 +25,9 @@ func resourceDomainsRRSetV2() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceDomainsRRSetV2ImportState,
 		},
+		CustomizeDiff: customdiff.All(
+			validateDomainsRRSetV2Records,
+		),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -35,9 +40,10 @@ func resourceDomainsRRSetV2() *schema.Resource {
 				ForceNew: true,
 			},
 			"type": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(rrsetV2Types, false),
 			},
 			"project_id": {
 				Type:     schema.TypeString,
@@ -53,8 +59,9 @@ func resourceDomainsRRSetV2() *schema.Resource {
 				Computed: true,
 			},
 			"ttl": {
-				Type:     schema.TypeInt,
-				Required: true,
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(60, 604800),
 			},
 			"records": {
 				Type:     schema.TypeSet,
@@ -87,7 +94,7 @@ func resourceDomainsRRSetV2Create(ctx context.Context, d *schema.ResourceData, m
 
 	recordType := domainsV2.RecordType(d.Get("type").(string))
 	recordsSet := d.Get("records").(*schema.Set)
-	records := generateRecordsFromSet(recordsSet)
+	records := generateRecordsFromSet(recordType, recordsSet)
 	createOpts := domainsV2.RRSet{
 		Name:    d.Get("name").(string),
 		Type:    recordType,
@@ -193,7 +200,7 @@ func resourceDomainsRRSetV2Update(ctx context.Context, d *schema.ResourceData, m
 
 	if d.HasChanges("ttl", "comment", "records") {
 		recordsSet := d.Get("records").(*schema.Set)
-		records := generateRecordsFromSet(recordsSet)
+		records := generateRecordsFromSet(domainsV2.RecordType(d.Get("type").(string)), recordsSet)
 
 		updateOpts := domainsV2.RRSet{
 			Name:      d.Get("name").(string),
@@ -0,0 +1,193 @@
+package selectel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	domainsV2 "github.com/selectel/domains-go/pkg/v2"
+)
+
+const (
+	zoneDelegationStatusDelegated    = "DELEGATED"
+	zoneDelegationStatusNotDelegated = "NOT_DELEGATED"
+)
+
+func resourceDomainsZoneDelegationV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDomainsZoneDelegationV2Create,
+		ReadContext:   resourceDomainsZoneDelegationV2Read,
+		DeleteContext: resourceDomainsZoneDelegationV2Delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"zone_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name_servers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"delegated": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"delegation_checked_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_delegated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDomainsZoneDelegationV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zoneID := d.Get("zone_id").(string)
+
+	client, err := getDomainsV2Client(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Print(msgGet(objectZone, zoneID))
+	zone, err := client.GetZone(ctx, zoneID, nil)
+	if err != nil {
+		return diag.FromErr(errGettingObject(objectZone, zoneID, err))
+	}
+
+	nameServers, err := getZoneNameServersV2(ctx, client, zone)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{zoneDelegationStatusNotDelegated},
+		Target:       []string{zoneDelegationStatusDelegated},
+		Refresh:      zoneDelegationV2StateRefreshFunc(ctx, client, zoneID),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        0,
+		PollInterval: 30 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for zone %s to become delegated to %s", zone.Name, strings.Join(nameServers, ", "))
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"error waiting for zone %s to become delegated, check that the domain uses the name servers %s at the registrar: %w",
+			zone.Name, strings.Join(nameServers, ", "), err,
+		))
+	}
+
+	d.SetId(zoneID)
+
+	return resourceDomainsZoneDelegationV2Read(ctx, d, meta)
+}
+
+// resourceDomainsZoneDelegationV2Read removes the resource from the state only when the
+// zone is deleted. A failed delegation check is reported in delegated, because a
+// single check can fail while the domain is still delegated.
+func resourceDomainsZoneDelegationV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getDomainsV2Client(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	zoneID := d.Id()
+
+	log.Print(msgGet(objectZone, zoneID))
+	zone, err := client.GetZone(ctx, zoneID, nil)
+	if errors.Is(err, domainsV2.ErrNotFound) {
+		log.Printf("[WARN] Zone %s not found, removing delegation from the state", zoneID)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(errGettingObject(objectZone, zoneID, err))
+	}
+
+	delegated := zoneDelegationV2Status(zone) == zoneDelegationStatusDelegated
+	if !delegated {
+		log.Printf("[WARN] The last delegation check of zone %s failed, check that the domain uses the name servers of the zone", zone.Name)
+	}
+
+	nameServers, err := getZoneNameServersV2(ctx, client, zone)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("zone_name", zone.Name)
+	d.Set("name_servers", nameServers)
+	d.Set("delegated", delegated)
+	d.Set("delegation_checked_at", zone.DelegationCheckedAt.Format(time.RFC3339))
+	d.Set("last_delegated_at", zone.LastDelegatedAt.Format(time.RFC3339))
+
+	return nil
+}
+
+// resourceDomainsZoneDelegationV2Delete only removes the resource from the state.
+func resourceDomainsZoneDelegationV2Delete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	d.SetId("")
+
+	return nil
+}
+
+func zoneDelegationV2StateRefreshFunc(ctx context.Context, client domainsV2.DNSClient[domainsV2.Zone, domainsV2.RRSet], zoneID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		zone, err := client.GetZone(ctx, zoneID, nil)
+		if err != nil {
+			return nil, "", errGettingObject(objectZone, zoneID, err)
+		}
+
+		return zone, zoneDelegationV2Status(zone), nil
+	}
+}
+
+func zoneDelegationV2Status(zone *domainsV2.Zone) string {
+	if zone.LastCheckStatus {
+		return zoneDelegationStatusDelegated
+	}
+
+	return zoneDelegationStatusNotDelegated
+}
+
+// getZoneNameServersV2 returns the sorted name servers from the NS RRSet of the zone
+// apex. These are the name servers the domain must be delegated to.
+func getZoneNameServersV2(ctx context.Context, client domainsV2.DNSClient[domainsV2.Zone, domainsV2.RRSet], zone *domainsV2.Zone) ([]string, error) {
+	rrset, err := getRRSetByNameAndType(ctx, client, zone.ID, zone.Name, string(domainsV2.NS))
+	if err != nil {
+		return nil, err
+	}
+
+	nameServers := make([]string, 0, len(rrset.Records))
+	for _, record := range rrset.Records {
+		nameServers = append(nameServers, record.Content)
+	}
+	sort.Strings(nameServers)
+
+	return nameServers, nil
+}
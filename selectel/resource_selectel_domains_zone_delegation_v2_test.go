@@ -0,0 +1,85 @@
+package selectel
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	domainsV2 "github.com/selectel/domains-go/pkg/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccDomainsZoneDelegationV2NotDelegated(t *testing.T) {
+	projectName := acctest.RandomWithPrefix("tf-acc")
+	testZoneName := fmt.Sprintf("%s.ru.", acctest.RandomWithPrefix("tf-acc"))
+	resourceZoneName := "zone_tf_acc_test_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSelectelPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDomainsV2ZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDomainsZoneDelegationV2Basic(projectName, resourceZoneName, testZoneName),
+				ExpectError: regexp.MustCompile("error waiting for zone .* to become delegated"),
+			},
+		},
+	})
+}
+
+func testAccDomainsZoneDelegationV2Basic(projectName, resourceZoneName, zoneName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+	resource "selectel_domains_zone_delegation_v2" "zone_delegation_tf_acc_test_1" {
+		zone_id    = selectel_domains_zone_v2.%[2]s.id
+		project_id = selectel_vpc_project_v2.project_tf_acc_test_1.id
+
+		timeouts {
+			create = "1m"
+		}
+	}`, testAccDomainsZoneV2Basic(projectName, resourceZoneName, zoneName), resourceZoneName)
+}
+
+func TestGetZoneNameServersV2(t *testing.T) {
+	ctx := context.Background()
+	zone := &domainsV2.Zone{ID: "mocked-zone-id", Name: "test.xyz."}
+	mDNSClient := new(mockedDNSv2Client)
+	opts := &map[string]string{
+		"name":        zone.Name,
+		"rrset_types": string(domainsV2.NS),
+		"limit":       "1000",
+		"offset":      "0",
+	}
+	rrsets := domainsV2.Listable[domainsV2.RRSet](domainsV2.List[domainsV2.RRSet]{
+		Count: 1,
+		Items: []*domainsV2.RRSet{
+			{
+				ID:   "mocked-uuid-1",
+				Name: zone.Name,
+				Type: domainsV2.NS,
+				Records: []domainsV2.RecordItem{
+					{Content: "b.ns.selectel.ru."},
+					{Content: "a.ns.selectel.ru."},
+				},
+			},
+		},
+	})
+	mDNSClient.On("ListRRSets", ctx, zone.ID, opts).Return(rrsets, nil)
+
+	nameServers, err := getZoneNameServersV2(ctx, mDNSClient, zone)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a.ns.selectel.ru.", "b.ns.selectel.ru."}, nameServers)
+}
+
+func TestZoneDelegationV2Status(t *testing.T) {
+	zone := &domainsV2.Zone{}
+	assert.Equal(t, zoneDelegationStatusNotDelegated, zoneDelegationV2Status(zone))
+
+	zone.LastCheckStatus = true
+	assert.Equal(t, zoneDelegationStatusDelegated, zoneDelegationV2Status(zone))
+}
@@ -0,0 +1,262 @@
+package selectel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	domainsV2 "github.com/selectel/domains-go/pkg/v2"
+)
+
+func resourceDomainsZoneExclusiveRRSetsV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDomainsZoneExclusiveRRSetsV2Create,
+		ReadContext:   resourceDomainsZoneExclusiveRRSetsV2Read,
+		UpdateContext: resourceDomainsZoneExclusiveRRSetsV2Update,
+		DeleteContext: resourceDomainsZoneExclusiveRRSetsV2Delete,
+		CustomizeDiff: customdiff.All(
+			planZoneExclusiveRRSetsV2Deletion,
+		),
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rrset_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ignore_managed_by": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ignore_rrsets": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ignore_apex_ns": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"zone_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"unmanaged_rrsets": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+// resourceDomainsZoneExclusiveRRSetsV2Create doesn't delete anything. It records the
+// undeclared RRSets in unmanaged_rrsets, so their deletion is shown in the next plan.
+func resourceDomainsZoneExclusiveRRSetsV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("zone_id").(string))
+
+	return resourceDomainsZoneExclusiveRRSetsV2Read(ctx, d, meta)
+}
+
+func resourceDomainsZoneExclusiveRRSetsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getDomainsV2Client(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	zoneID := d.Id()
+
+	log.Print(msgGet(objectZone, zoneID))
+	zone, err := client.GetZone(ctx, zoneID, nil)
+	if errors.Is(err, domainsV2.ErrNotFound) {
+		log.Printf("[WARN] Zone %s not found, removing exclusive RRSets from the state", zoneID)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(errGettingObject(objectZone, zoneID, err))
+	}
+
+	unmanaged, err := getZoneExclusiveRRSetsV2Unmanaged(ctx, d, client, zone)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	unmanagedIDs := make(map[string]interface{}, len(unmanaged))
+	for _, rrset := range unmanaged {
+		unmanagedIDs[rrsetKey(rrset.Name, string(rrset.Type))] = rrset.ID
+	}
+
+	d.Set("zone_name", zone.Name)
+	d.Set("unmanaged_rrsets", unmanagedIDs)
+
+	return nil
+}
+
+// resourceDomainsZoneExclusiveRRSetsV2Update deletes only the RRSets that were in
+// unmanaged_rrsets when the plan was made. RRSets created after the last refresh
+// are left for the next plan.
+func resourceDomainsZoneExclusiveRRSetsV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	planned, _ := d.GetChange("unmanaged_rrsets")
+
+	return deleteZoneExclusiveRRSetsV2Planned(ctx, d, meta, planned.(map[string]interface{}))
+}
+
+// resourceDomainsZoneExclusiveRRSetsV2Delete only removes the resource from the state.
+// RRSets in the zone are left unchanged.
+func resourceDomainsZoneExclusiveRRSetsV2Delete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	d.SetId("")
+
+	return nil
+}
+
+// planZoneExclusiveRRSetsV2Deletion plans an update when the last read found RRSets
+// that are not declared in rrset_ids, so their deletion is shown in the plan.
+func planZoneExclusiveRRSetsV2Deletion(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || len(d.Get("unmanaged_rrsets").(map[string]interface{})) == 0 {
+		return nil
+	}
+
+	return d.SetNew("unmanaged_rrsets", map[string]interface{}{})
+}
+
+func deleteZoneExclusiveRRSetsV2Planned(ctx context.Context, d *schema.ResourceData, meta interface{}, planned map[string]interface{}) diag.Diagnostics {
+	client, err := getDomainsV2Client(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Print(msgGet(objectZone, d.Id()))
+	zone, err := client.GetZone(ctx, d.Id(), nil)
+	if err != nil {
+		return diag.FromErr(errGettingObject(objectZone, d.Id(), err))
+	}
+	d.Set("zone_name", zone.Name)
+
+	unmanaged, err := getZoneExclusiveRRSetsV2Unmanaged(ctx, d, client, zone)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	remaining := make(map[string]interface{}, len(planned))
+	for key, id := range planned {
+		remaining[key] = id
+	}
+
+	for _, rrset := range filterZoneExclusiveRRSetsV2Planned(unmanaged, planned) {
+		log.Print(msgDelete(objectRRSet, fmt.Sprintf("zone_id: %s, rrset_id: %s", d.Id(), rrset.ID)))
+		err := client.DeleteRRSet(ctx, d.Id(), rrset.ID)
+		if err != nil {
+			d.Set("unmanaged_rrsets", remaining)
+			return diag.FromErr(errDeletingObject(objectRRSet, rrset.ID, err))
+		}
+		delete(remaining, rrsetKey(rrset.Name, string(rrset.Type)))
+	}
+
+	d.Set("unmanaged_rrsets", map[string]interface{}{})
+
+	return nil
+}
+
+// filterZoneExclusiveRRSetsV2Planned returns the unmanaged RRSets whose IDs are in
+// planned. An RRSet declared or ignored since the plan is not in unmanaged and is
+// kept, as is an RRSet created after the plan.
+func filterZoneExclusiveRRSetsV2Planned(unmanaged []*domainsV2.RRSet, planned map[string]interface{}) []*domainsV2.RRSet {
+	plannedIDs := make(map[string]struct{}, len(planned))
+	for _, id := range planned {
+		plannedIDs[id.(string)] = struct{}{}
+	}
+
+	var filtered []*domainsV2.RRSet
+	for _, rrset := range unmanaged {
+		if _, ok := plannedIDs[rrset.ID]; ok {
+			filtered = append(filtered, rrset)
+		}
+	}
+
+	return filtered
+}
+
+func getZoneExclusiveRRSetsV2Unmanaged(ctx context.Context, d *schema.ResourceData, client domainsV2.DNSClient[domainsV2.Zone, domainsV2.RRSet], zone *domainsV2.Zone) ([]*domainsV2.RRSet, error) {
+	rrsets, err := listAllRRSets(ctx, client, zone.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	unmanaged := findZoneExclusiveRRSetsV2Unmanaged(zone.Name, rrsets, zoneExclusiveRRSetsV2Opts{
+		rrsetIDs:        convertToStringSlice(d.Get("rrset_ids").(*schema.Set).List()),
+		ignoreManagedBy: convertToStringSlice(d.Get("ignore_managed_by").(*schema.Set).List()),
+		ignoreRRSets:    convertToStringSlice(d.Get("ignore_rrsets").(*schema.Set).List()),
+		ignoreApexNS:    d.Get("ignore_apex_ns").(bool),
+	})
+
+	return unmanaged, nil
+}
+
+type zoneExclusiveRRSetsV2Opts struct {
+	rrsetIDs        []string
+	ignoreManagedBy []string
+	// ignoreRRSets contains keys in the <name>/<type> format.
+	ignoreRRSets []string
+	ignoreApexNS bool
+}
+
+// findZoneExclusiveRRSetsV2Unmanaged returns RRSets of the zone that are neither
+// declared nor ignored, sorted by name and type. The SOA RRSet is always ignored
+// because DNS Hosting manages it.
+func findZoneExclusiveRRSetsV2Unmanaged(zoneName string, rrsets []*domainsV2.RRSet, opts zoneExclusiveRRSetsV2Opts) []*domainsV2.RRSet {
+	ignoredKeys := make(map[string]struct{}, len(opts.ignoreRRSets))
+	for _, key := range opts.ignoreRRSets {
+		name, recordType, ok := strings.Cut(key, "/")
+		if !ok {
+			continue
+		}
+		ignoredKeys[rrsetKey(name, recordType)] = struct{}{}
+	}
+
+	var unmanaged []*domainsV2.RRSet
+	for _, rrset := range rrsets {
+		apex := fqdn(rrset.Name) == fqdn(zoneName)
+		switch {
+		case containsString(opts.rrsetIDs, rrset.ID):
+		case rrset.Type == domainsV2.SOA:
+		case rrset.Type == domainsV2.NS && apex && opts.ignoreApexNS:
+		case rrset.ManagedBy != "" && containsString(opts.ignoreManagedBy, rrset.ManagedBy):
+		default:
+			if _, ok := ignoredKeys[rrsetKey(rrset.Name, string(rrset.Type))]; !ok {
+				unmanaged = append(unmanaged, rrset)
+			}
+		}
+	}
+	sort.Slice(unmanaged, func(i, j int) bool {
+		return rrsetKey(unmanaged[i].Name, string(unmanaged[i].Type)) <
+			rrsetKey(unmanaged[j].Name, string(unmanaged[j].Type))
+	})
+
+	return unmanaged
+}
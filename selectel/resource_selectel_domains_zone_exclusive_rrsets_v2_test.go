@@ -0,0 +1,85 @@
+package selectel
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	domainsV2 "github.com/selectel/domains-go/pkg/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccDomainsZoneExclusiveRRSetsV2Basic(t *testing.T) {
+	projectName := acctest.RandomWithPrefix("tf-acc")
+	testZoneName := fmt.Sprintf("%s.ru.", acctest.RandomWithPrefix("tf-acc"))
+	resourceZoneName := "zone_tf_acc_test_1"
+	resourceExclusiveName := "selectel_domains_zone_exclusive_rrsets_v2.zone_exclusive_rrsets_tf_acc_test_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSelectelPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDomainsV2ZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainsZoneExclusiveRRSetsV2Basic(projectName, resourceZoneName, testZoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceExclusiveName, "zone_name", testZoneName),
+					resource.TestCheckResourceAttr(resourceExclusiveName, "rrset_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceExclusiveName, "unmanaged_rrsets.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDomainsZoneExclusiveRRSetsV2Basic(projectName, resourceZoneName, zoneName string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+	resource "selectel_domains_zone_exclusive_rrsets_v2" "zone_exclusive_rrsets_tf_acc_test_1" {
+		zone_id    = selectel_domains_zone_v2.%[2]s.id
+		project_id = selectel_vpc_project_v2.project_tf_acc_test_1.id
+		rrset_ids  = [selectel_domains_rrset_v2.rrset_tf_acc_test_1.id]
+	}`, testAccDomainsRRSetV2WithZoneBasic(projectName, "rrset_tf_acc_test_1", zoneName, "A", "127.0.0.1", 60, resourceZoneName, zoneName), resourceZoneName)
+}
+
+func TestFindZoneExclusiveRRSetsV2Unmanaged(t *testing.T) {
+	rrsets := []*domainsV2.RRSet{
+		{ID: "soa", Name: "example.com.", Type: domainsV2.SOA},
+		{ID: "apex-ns", Name: "example.com.", Type: domainsV2.NS},
+		{ID: "sub-ns", Name: "sub.example.com.", Type: domainsV2.NS},
+		{ID: "declared", Name: "example.com.", Type: domainsV2.A},
+		{ID: "system", Name: "system.example.com.", Type: domainsV2.TXT, ManagedBy: "system"},
+		{ID: "acme", Name: "_acme-challenge.example.com.", Type: domainsV2.TXT, ManagedBy: "cert-manager"},
+		{ID: "ignored", Name: "keep.example.com.", Type: domainsV2.CNAME},
+		{ID: "manual", Name: "manual.example.com.", Type: domainsV2.A},
+	}
+
+	unmanaged := findZoneExclusiveRRSetsV2Unmanaged("example.com", rrsets, zoneExclusiveRRSetsV2Opts{
+		rrsetIDs:        []string{"declared"},
+		ignoreManagedBy: []string{"system"},
+		ignoreRRSets:    []string{"KEEP.example.com/cname"},
+		ignoreApexNS:    true,
+	})
+	assert.Equal(t, []*domainsV2.RRSet{rrsets[5], rrsets[7], rrsets[2]}, unmanaged)
+
+	unmanaged = findZoneExclusiveRRSetsV2Unmanaged("example.com.", rrsets, zoneExclusiveRRSetsV2Opts{
+		rrsetIDs: []string{"declared", "sub-ns", "system", "acme", "ignored", "manual"},
+	})
+	assert.Equal(t, []*domainsV2.RRSet{rrsets[1]}, unmanaged)
+}
+
+func TestFilterZoneExclusiveRRSetsV2Planned(t *testing.T) {
+	unmanaged := []*domainsV2.RRSet{
+		{ID: "acme", Name: "_acme-challenge.example.com.", Type: domainsV2.TXT},
+		{ID: "manual", Name: "manual.example.com.", Type: domainsV2.A},
+	}
+	planned := map[string]interface{}{
+		"manual.example.com./A":   "manual",
+		"declared.example.com./A": "declared",
+	}
+
+	assert.Equal(t, []*domainsV2.RRSet{unmanaged[1]}, filterZoneExclusiveRRSetsV2Planned(unmanaged, planned))
+	assert.Empty(t, filterZoneExclusiveRRSetsV2Planned(unmanaged, map[string]interface{}{}))
+}
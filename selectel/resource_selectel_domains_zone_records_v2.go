@@ -0,0 +1,511 @@
+package selectel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	domainsV2 "github.com/selectel/domains-go/pkg/v2"
+)
+
+const (
+	zoneRecordsDefaultTTL = 3600
+	// zoneRecordsPlaceholderOrigin is used to validate a zone file before the zone
+	// is created.
+	zoneRecordsPlaceholderOrigin = "zone.invalid."
+)
+
+func resourceDomainsZoneRecordsV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDomainsZoneRecordsV2Create,
+		ReadContext:   resourceDomainsZoneRecordsV2Read,
+		UpdateContext: resourceDomainsZoneRecordsV2Update,
+		DeleteContext: resourceDomainsZoneRecordsV2Delete,
+		CustomizeDiff: customdiff.All(
+			refreshZoneRecordsV2RRSetsDiff,
+		),
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"zone_file": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"default_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      zoneRecordsDefaultTTL,
+				ValidateFunc: validation.IntBetween(60, 604800),
+			},
+			"manage_apex_ns": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"adopt_existing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"zone_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rrset_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"rrsets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"records": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDomainsZoneRecordsV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zoneID := d.Get("zone_id").(string)
+
+	client, err := getDomainsV2Client(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Print(msgGet(objectZone, zoneID))
+	zone, err := client.GetZone(ctx, zoneID, nil)
+	if err != nil {
+		return diag.FromErr(errGettingObject(objectZone, zoneID, err))
+	}
+
+	d.Set("zone_name", zone.Name)
+
+	desired, err := parseZoneRecordsV2ZoneFile(d.Get)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(zoneID)
+
+	diagErr := applyZoneRecordsV2(ctx, d, client, desired, map[string]interface{}{})
+	if diagErr != nil {
+		return diagErr
+	}
+
+	return resourceDomainsZoneRecordsV2Read(ctx, d, meta)
+}
+
+func resourceDomainsZoneRecordsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getDomainsV2Client(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	zoneID := d.Id()
+
+	log.Print(msgGet(objectZone, zoneID))
+	zone, err := client.GetZone(ctx, zoneID, nil)
+	if errors.Is(err, domainsV2.ErrNotFound) {
+		log.Printf("[WARN] Zone %s not found, removing zone records from the state", zoneID)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(errGettingObject(objectZone, zoneID, err))
+	}
+	d.Set("zone_name", zone.Name)
+
+	rrsets, err := listAllRRSets(ctx, client, zoneID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	managedIDs := d.Get("rrset_ids").(map[string]interface{})
+	actualIDs := map[string]interface{}{}
+	var actual []zoneFileRRSet
+	for _, rrset := range rrsets {
+		key := rrsetKey(rrset.Name, string(rrset.Type))
+		if _, ok := managedIDs[key]; !ok {
+			continue
+		}
+		actualIDs[key] = rrset.ID
+		actual = append(actual, zoneFileRRSetFromRRSet(rrset))
+	}
+	sortZoneFileRRSets(actual)
+
+	d.Set("rrset_ids", actualIDs)
+	if err := d.Set("rrsets", flattenZoneFileRRSets(actual)); err != nil {
+		log.Print(errSettingComplexAttr("rrsets", err))
+	}
+
+	return nil
+}
+
+func resourceDomainsZoneRecordsV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getDomainsV2Client(d, meta)
+	if err != nil {
+		return diag.FromErr(errUpdatingObject(objectRRSet, d.Id(), err))
+	}
+
+	desired, err := parseZoneRecordsV2ZoneFile(d.Get)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	oldIDs, _ := d.GetChange("rrset_ids")
+	diagErr := applyZoneRecordsV2(ctx, d, client, desired, oldIDs.(map[string]interface{}))
+	if diagErr != nil {
+		return diagErr
+	}
+
+	return resourceDomainsZoneRecordsV2Read(ctx, d, meta)
+}
+
+// resourceDomainsZoneRecordsV2Delete deletes the managed RRSets, including adopted
+// ones. The NS RRSet of the zone apex is left in the zone, because the zone doesn't
+// resolve without it.
+func resourceDomainsZoneRecordsV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := getDomainsV2Client(d, meta)
+	if err != nil {
+		return diag.FromErr(errDeletingObject(objectRRSet, d.Id(), err))
+	}
+
+	apexNSKey := rrsetKey(d.Get("zone_name").(string), string(domainsV2.NS))
+	for key, rrsetID := range d.Get("rrset_ids").(map[string]interface{}) {
+		if key == apexNSKey {
+			log.Printf("[DEBUG] Keeping NS RRSet %s of the zone apex in the zone %s", rrsetID, d.Id())
+			continue
+		}
+
+		log.Print(msgDelete(objectRRSet, fmt.Sprintf("zone_id: %s, rrset_id: %s", d.Id(), rrsetID)))
+		err := client.DeleteRRSet(ctx, d.Id(), rrsetID.(string))
+		if err != nil {
+			return diag.FromErr(errDeletingObject(objectRRSet, rrsetID.(string), err))
+		}
+	}
+
+	return nil
+}
+
+// refreshZoneRecordsV2RRSetsDiff compares the RRSets parsed from zone_file with the
+// RRSets in the state, so changes made outside of Terraform are planned for update.
+// On creation there is no state, so zone_file is only validated.
+func refreshZoneRecordsV2RRSetsDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("zone_file") {
+		return nil
+	}
+	if d.Id() == "" {
+		return validateZoneRecordsV2ZoneFile(ctx, d, meta)
+	}
+
+	desired, err := parseZoneRecordsV2ZoneFile(d.Get)
+	if err != nil {
+		return err
+	}
+
+	desiredRRSets := flattenZoneFileRRSets(desired)
+	if reflect.DeepEqual(desiredRRSets, d.Get("rrsets")) {
+		return nil
+	}
+	if err := d.SetNew("rrsets", desiredRRSets); err != nil {
+		return err
+	}
+
+	return d.SetNewComputed("rrset_ids")
+}
+
+// validateZoneRecordsV2ZoneFile parses zone_file when the resource is planned for
+// creation, so an invalid zone file fails the plan before any RRSet is created. If the
+// zone doesn't exist yet, owner names are not checked against the zone name.
+func validateZoneRecordsV2ZoneFile(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	opts := zoneFileParseOpts{
+		origin:        zoneRecordsPlaceholderOrigin,
+		defaultTTL:    d.Get("default_ttl").(int),
+		includeApexNS: d.Get("manage_apex_ns").(bool),
+		anyZone:       true,
+	}
+
+	if d.NewValueKnown("zone_id") && d.NewValueKnown("project_id") {
+		client, err := getDomainsV2ClientForProject(meta, d.Get("project_id").(string))
+		if err != nil {
+			return err
+		}
+
+		zoneID := d.Get("zone_id").(string)
+		log.Print(msgGet(objectZone, zoneID))
+		zone, err := client.GetZone(ctx, zoneID, nil)
+		if err != nil {
+			return errGettingObject(objectZone, zoneID, err)
+		}
+		opts.origin = zone.Name
+		opts.anyZone = false
+	}
+
+	_, err := parseZoneFile(d.Get("zone_file").(string), opts)
+
+	return err
+}
+
+// parseZoneRecordsV2ZoneFile parses zone_file with the settings of the resource. It
+// accepts the Get method of schema.ResourceData or schema.ResourceDiff.
+func parseZoneRecordsV2ZoneFile(get func(key string) interface{}) ([]zoneFileRRSet, error) {
+	return parseZoneFile(get("zone_file").(string), zoneFileParseOpts{
+		origin:        get("zone_name").(string),
+		defaultTTL:    get("default_ttl").(int),
+		includeApexNS: get("manage_apex_ns").(bool),
+	})
+}
+
+// applyZoneRecordsV2 creates or updates RRSets from zone_file and deletes RRSets that
+// were managed before but are no longer in the zone file.
+func applyZoneRecordsV2(ctx context.Context, d *schema.ResourceData, client domainsV2.DNSClient[domainsV2.Zone, domainsV2.RRSet], desired []zoneFileRRSet, previousIDs map[string]interface{}) diag.Diagnostics {
+	zoneID := d.Id()
+
+	rrsets, err := listAllRRSets(ctx, client, zoneID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	existing := map[string]*domainsV2.RRSet{}
+	for _, rrset := range rrsets {
+		existing[rrsetKey(rrset.Name, string(rrset.Type))] = rrset
+	}
+
+	previousIDs = releaseZoneRecordsV2ApexNS(d.Get("zone_name").(string), desired, previousIDs)
+	changes := planZoneRecordsV2Changes(desired, existing, previousIDs)
+	if len(changes.adopt) > 0 && !d.Get("adopt_existing").(bool) {
+		return diag.FromErr(fmt.Errorf(
+			"RRSets %s already exist in the zone %s and aren't managed by this resource, "+
+				"remove them from zone_file or set adopt_existing to true to replace them",
+			strings.Join(changes.adopt, ", "), zoneID,
+		))
+	}
+
+	managedIDs := map[string]interface{}{}
+	for key, rrset := range existing {
+		if _, ok := previousIDs[key]; ok {
+			managedIDs[key] = rrset.ID
+		}
+	}
+
+	for _, rrset := range changes.delete {
+		log.Print(msgDelete(objectRRSet, fmt.Sprintf("zone_id: %s, rrset_id: %s", zoneID, rrset.ID)))
+		err := client.DeleteRRSet(ctx, zoneID, rrset.ID)
+		if err != nil {
+			d.Set("rrset_ids", managedIDs)
+			return diag.FromErr(errDeletingObject(objectRRSet, rrset.ID, err))
+		}
+		delete(managedIDs, rrsetKey(rrset.Name, string(rrset.Type)))
+	}
+
+	for _, rrset := range changes.update {
+		updateOpts := rrsetFromZoneFileRRSet(rrset.desired, zoneID, rrset.existing)
+		updateOpts.Comment = rrset.existing.Comment
+		updateOpts.ManagedBy = rrset.existing.ManagedBy
+
+		log.Print(msgUpdate(objectRRSet, rrset.existing.ID, updateOpts))
+		err := client.UpdateRRSet(ctx, zoneID, rrset.existing.ID, &updateOpts)
+		if err != nil {
+			d.Set("rrset_ids", managedIDs)
+			return diag.FromErr(errUpdatingObject(objectRRSet, rrset.existing.ID, err))
+		}
+		managedIDs[rrset.desired.key()] = rrset.existing.ID
+	}
+
+	for _, rrset := range changes.create {
+		createOpts := rrsetFromZoneFileRRSet(rrset, zoneID, nil)
+
+		log.Print(msgCreate(objectRRSet, createOpts))
+		created, err := client.CreateRRSet(ctx, zoneID, &createOpts)
+		if err != nil {
+			d.Set("rrset_ids", managedIDs)
+			return diag.FromErr(errCreatingObject(objectRRSet, err))
+		}
+		managedIDs[rrset.key()] = created.ID
+	}
+
+	d.Set("rrset_ids", managedIDs)
+
+	return nil
+}
+
+// releaseZoneRecordsV2ApexNS stops managing the NS RRSet of the zone apex when it is no
+// longer in the desired RRSets, so it is left in the zone instead of being deleted.
+func releaseZoneRecordsV2ApexNS(zoneName string, desired []zoneFileRRSet, previousIDs map[string]interface{}) map[string]interface{} {
+	apexNSKey := rrsetKey(zoneName, string(domainsV2.NS))
+	if _, ok := previousIDs[apexNSKey]; !ok {
+		return previousIDs
+	}
+	for _, rrset := range desired {
+		if rrset.key() == apexNSKey {
+			return previousIDs
+		}
+	}
+
+	released := make(map[string]interface{}, len(previousIDs))
+	for key, id := range previousIDs {
+		if key != apexNSKey {
+			released[key] = id
+		}
+	}
+
+	return released
+}
+
+type zoneRecordsV2Update struct {
+	desired  zoneFileRRSet
+	existing *domainsV2.RRSet
+}
+
+type zoneRecordsV2Changes struct {
+	create []zoneFileRRSet
+	update []zoneRecordsV2Update
+	delete []*domainsV2.RRSet
+	adopt  []string
+}
+
+// planZoneRecordsV2Changes compares desired RRSets with RRSets existing in the zone.
+// An existing RRSet with the same name and type is updated, and its key is added to
+// adopt if it wasn't managed before. Existing RRSets are only deleted if they were
+// managed before.
+func planZoneRecordsV2Changes(desired []zoneFileRRSet, existing map[string]*domainsV2.RRSet, previousIDs map[string]interface{}) zoneRecordsV2Changes {
+	var changes zoneRecordsV2Changes
+
+	desiredKeys := map[string]struct{}{}
+	for _, rrset := range desired {
+		desiredKeys[rrset.key()] = struct{}{}
+
+		current, ok := existing[rrset.key()]
+		if !ok {
+			changes.create = append(changes.create, rrset)
+			continue
+		}
+		if _, ok := previousIDs[rrset.key()]; !ok {
+			changes.adopt = append(changes.adopt, rrset.key())
+		}
+		if !reflect.DeepEqual(zoneFileRRSetFromRRSet(current), rrset) {
+			changes.update = append(changes.update, zoneRecordsV2Update{desired: rrset, existing: current})
+		}
+	}
+
+	for key := range previousIDs {
+		if _, ok := desiredKeys[key]; ok {
+			continue
+		}
+		if current, ok := existing[key]; ok {
+			changes.delete = append(changes.delete, current)
+		}
+	}
+	sort.Strings(changes.adopt)
+	sort.Slice(changes.delete, func(i, j int) bool {
+		return rrsetKey(changes.delete[i].Name, string(changes.delete[i].Type)) <
+			rrsetKey(changes.delete[j].Name, string(changes.delete[j].Type))
+	})
+
+	return changes
+}
+
+func zoneFileRRSetFromRRSet(rrset *domainsV2.RRSet) zoneFileRRSet {
+	records := make([]string, 0, len(rrset.Records))
+	for _, record := range rrset.Records {
+		records = append(records, canonicalRRSetV2RecordContent(rrset.Type, record.Content))
+	}
+	sort.Strings(records)
+
+	return zoneFileRRSet{
+		name:       fqdn(rrset.Name),
+		recordType: string(rrset.Type),
+		ttl:        rrset.TTL,
+		records:    records,
+	}
+}
+
+// rrsetFromZoneFileRRSet builds an RRSet for the create or update request. Records of
+// the existing RRSet that are disabled and whose content did not change stay disabled,
+// as the zone file can't express the flag.
+func rrsetFromZoneFileRRSet(rrset zoneFileRRSet, zoneID string, existing *domainsV2.RRSet) domainsV2.RRSet {
+	recordType := domainsV2.RecordType(rrset.recordType)
+
+	disabled := map[string]bool{}
+	if existing != nil {
+		for _, record := range existing.Records {
+			if record.Disabled {
+				disabled[canonicalRRSetV2RecordContent(existing.Type, record.Content)] = true
+			}
+		}
+	}
+
+	records := make([]domainsV2.RecordItem, len(rrset.records))
+	for i, content := range rrset.records {
+		records[i] = domainsV2.RecordItem{
+			Content:  expandRRSetV2RecordContent(recordType, content),
+			Disabled: disabled[content],
+		}
+	}
+
+	return domainsV2.RRSet{
+		Name:    rrset.name,
+		Type:    recordType,
+		TTL:     rrset.ttl,
+		ZoneID:  zoneID,
+		Records: records,
+	}
+}
+
+func flattenZoneFileRRSets(rrsets []zoneFileRRSet) []interface{} {
+	result := make([]interface{}, len(rrsets))
+	for i, rrset := range rrsets {
+		records := make([]interface{}, len(rrset.records))
+		for j, record := range rrset.records {
+			records[j] = record
+		}
+		result[i] = map[string]interface{}{
+			"name":    rrset.name,
+			"type":    rrset.recordType,
+			"ttl":     rrset.ttl,
+			"records": records,
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,138 @@
+package selectel
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	domainsV2 "github.com/selectel/domains-go/pkg/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccDomainsZoneRecordsV2Basic(t *testing.T) {
+	projectName := acctest.RandomWithPrefix("tf-acc")
+	testZoneName := fmt.Sprintf("%s.ru.", acctest.RandomWithPrefix("tf-acc"))
+	resourceZoneName := "zone_tf_acc_test_1"
+	resourceZoneRecordsName := "selectel_domains_zone_records_v2.zone_records_tf_acc_test_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccSelectelPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckDomainsV2ZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainsZoneRecordsV2Basic(projectName, resourceZoneName, testZoneName, `
+$TTL 300
+@    A     192.0.2.1
+www  CNAME @
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceZoneRecordsName, "zone_name", testZoneName),
+					resource.TestCheckResourceAttr(resourceZoneRecordsName, "rrsets.#", "2"),
+					resource.TestCheckResourceAttr(resourceZoneRecordsName, "rrset_ids.%", "2"),
+				),
+			},
+			{
+				Config: testAccDomainsZoneRecordsV2Basic(projectName, resourceZoneName, testZoneName, `
+$TTL 300
+@    A     192.0.2.2
+mail MX    10 @
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceZoneRecordsName, "rrsets.#", "2"),
+					resource.TestCheckResourceAttr(resourceZoneRecordsName, "rrsets.0.records.0", "192.0.2.2"),
+					resource.TestCheckResourceAttr(resourceZoneRecordsName, "rrsets.1.type", "MX"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDomainsZoneRecordsV2Basic(projectName, resourceZoneName, zoneName, zoneFile string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+	resource "selectel_domains_zone_records_v2" "zone_records_tf_acc_test_1" {
+		zone_id    = selectel_domains_zone_v2.%[2]s.id
+		project_id = selectel_vpc_project_v2.project_tf_acc_test_1.id
+		zone_file  = <<-EOT
+%[3]s
+EOT
+	}`, testAccDomainsZoneV2Basic(projectName, resourceZoneName, zoneName), resourceZoneName, zoneFile)
+}
+
+func TestPlanZoneRecordsV2Changes(t *testing.T) {
+	desired := []zoneFileRRSet{
+		{name: "example.com.", recordType: "A", ttl: 300, records: []string{"192.0.2.1"}},
+		{name: "mail.example.com.", recordType: "MX", ttl: 300, records: []string{"10 example.com."}},
+		{name: "www.example.com.", recordType: "CNAME", ttl: 300, records: []string{"example.com."}},
+	}
+	existing := map[string]*domainsV2.RRSet{
+		"example.com./A": {
+			ID: "a", Name: "example.com.", Type: domainsV2.A, TTL: 300,
+			Records: []domainsV2.RecordItem{{Content: "192.0.2.1"}},
+		},
+		"www.example.com./CNAME": {
+			ID: "cname", Name: "www.example.com.", Type: domainsV2.CNAME, TTL: 60,
+			Records: []domainsV2.RecordItem{{Content: "example.com."}},
+		},
+		"old.example.com./A": {
+			ID: "old", Name: "old.example.com.", Type: domainsV2.A, TTL: 60,
+			Records: []domainsV2.RecordItem{{Content: "192.0.2.9"}},
+		},
+		"manual.example.com./A": {
+			ID: "manual", Name: "manual.example.com.", Type: domainsV2.A, TTL: 60,
+			Records: []domainsV2.RecordItem{{Content: "192.0.2.10"}},
+		},
+	}
+	previousIDs := map[string]interface{}{
+		"example.com./A":     "a",
+		"old.example.com./A": "old",
+	}
+
+	changes := planZoneRecordsV2Changes(desired, existing, previousIDs)
+
+	assert.Equal(t, []zoneFileRRSet{desired[1]}, changes.create)
+	assert.Len(t, changes.update, 1)
+	assert.Equal(t, "cname", changes.update[0].existing.ID)
+	assert.Equal(t, desired[2], changes.update[0].desired)
+	assert.Len(t, changes.delete, 1)
+	assert.Equal(t, "old", changes.delete[0].ID)
+	assert.Equal(t, []string{"www.example.com./CNAME"}, changes.adopt)
+}
+
+func TestReleaseZoneRecordsV2ApexNS(t *testing.T) {
+	previousIDs := map[string]interface{}{
+		"example.com./NS": "apex-ns",
+		"example.com./A":  "a",
+	}
+	desired := []zoneFileRRSet{{name: "example.com.", recordType: "A", ttl: 60, records: []string{"192.0.2.1"}}}
+
+	assert.Equal(t, map[string]interface{}{"example.com./A": "a"}, releaseZoneRecordsV2ApexNS("example.com.", desired, previousIDs))
+
+	desired = append(desired, zoneFileRRSet{name: "example.com.", recordType: "NS", ttl: 60, records: []string{"a.ns.selectel.ru."}})
+	assert.Equal(t, previousIDs, releaseZoneRecordsV2ApexNS("example.com.", desired, previousIDs))
+}
+
+func TestRRSetFromZoneFileRRSet(t *testing.T) {
+	desired := zoneFileRRSet{name: "example.com.", recordType: "A", ttl: 300, records: []string{"192.0.2.1", "192.0.2.3"}}
+	existing := &domainsV2.RRSet{
+		ID: "a", Name: "example.com.", Type: domainsV2.A, TTL: 60,
+		Records: []domainsV2.RecordItem{
+			{Content: "192.0.2.1", Disabled: true},
+			{Content: "192.0.2.2", Disabled: true},
+		},
+	}
+
+	rrset := rrsetFromZoneFileRRSet(desired, "zone-id", existing)
+	assert.Equal(t, "zone-id", rrset.ZoneID)
+	assert.Equal(t, 300, rrset.TTL)
+	assert.Equal(t, []domainsV2.RecordItem{
+		{Content: "192.0.2.1", Disabled: true},
+		{Content: "192.0.2.3"},
+	}, rrset.Records)
+
+	rrset = rrsetFromZoneFileRRSet(desired, "zone-id", nil)
+	assert.Equal(t, []domainsV2.RecordItem{{Content: "192.0.2.1"}, {Content: "192.0.2.3"}}, rrset.Records)
+}
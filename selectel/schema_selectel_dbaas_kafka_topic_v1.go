@@ -1,6 +1,9 @@
 package selectel
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
 
 func resourceDBaaSKafkaTopicV1Schema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
@@ -20,8 +23,9 @@ func resourceDBaaSKafkaTopicV1Schema() map[string]*schema.Schema {
 			ForceNew: true,
 		},
 		"partitions": {
-			Type:     schema.TypeInt,
-			Required: true,
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 4000),
 		},
 		"status": {
 			Type:     schema.TypeString,
@@ -54,8 +54,14 @@ func resourceDBaaSRedisDatastoreV1Schema() map[string]*schema.Schema {
 	datastoreSchema["redis_password"] = &schema.Schema{
 		Type:      schema.TypeString,
 		Optional:  true,
+		Computed:  true,
 		Sensitive: true,
 	}
+	datastoreSchema["redis_password_version"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Description: "Change this value to rotate a generated password.",
+	}
 	datastoreSchema["floating_ips"] = &schema.Schema{
 		Type:     schema.TypeSet,
 		Optional: true,
@@ -21,9 +21,15 @@ func resourceDBaaSUserV1Schema() map[string]*schema.Schema {
 		},
 		"password": {
 			Type:      schema.TypeString,
-			Required:  true,
+			Optional:  true,
+			Computed:  true,
 			Sensitive: true,
 		},
+		"password_version": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Change this value to rotate a generated password.",
+		},
 		"status": {
 			Type:     schema.TypeString,
 			Computed: true,
@@ -0,0 +1,69 @@
+package schemas
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ParseDBaaSFirewallIP parses a firewall entry that can be either a single
+// IP-address or a CIDR network. Host routes like 10.0.0.1/32 are returned as
+// single-address prefixes, networks with host bits set are rejected.
+func ParseDBaaSFirewallIP(value string) (netip.Prefix, error) {
+	value = strings.TrimSpace(value)
+	if !strings.Contains(value, "/") {
+		addr, err := netip.ParseAddr(value)
+		if err != nil || addr.Zone() != "" {
+			return netip.Prefix{}, fmt.Errorf("%q is not a valid IP-address or CIDR", value)
+		}
+		addr = addr.Unmap()
+
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("%q is not a valid IP-address or CIDR", value)
+	}
+	if masked := prefix.Masked(); masked != prefix {
+		return netip.Prefix{}, fmt.Errorf("%q has host bits set, use %q instead", value, masked.String())
+	}
+
+	return prefix, nil
+}
+
+// CanonicalDBaaSFirewallIP returns the canonical form of a firewall entry:
+// single addresses are written without a prefix length and IPv6 addresses
+// are compressed. Invalid values are returned unchanged.
+func CanonicalDBaaSFirewallIP(value string) string {
+	prefix, err := ParseDBaaSFirewallIP(value)
+	if err != nil {
+		return value
+	}
+	if prefix.IsSingleIP() {
+		return prefix.Addr().String()
+	}
+
+	return prefix.String()
+}
+
+// ValidateDBaaSFirewallIP is a schema.SchemaValidateFunc for firewall entries.
+func ValidateDBaaSFirewallIP(v interface{}, k string) ([]string, []error) {
+	value, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if _, err := ParseDBaaSFirewallIP(value); err != nil {
+		return nil, []error{fmt.Errorf("invalid %s: %w", k, err)}
+	}
+
+	return nil, nil
+}
+
+// HashDBaaSFirewallIP hashes the canonical form of a firewall entry so that
+// 10.0.0.1 and 10.0.0.1/32 are treated as the same set element.
+func HashDBaaSFirewallIP(v interface{}) int {
+	return schema.HashString(CanonicalDBaaSFirewallIP(v.(string)))
+}
@@ -20,11 +20,13 @@ func ResourceDBaaSFirewallV1Schema() map[string]*schema.Schema {
 			ForceNew: true,
 		},
 		"ips": {
-			Type:     schema.TypeList,
+			Type:     schema.TypeSet,
 			Required: true,
 			Elem: &schema.Schema{
-				Type: schema.TypeString,
+				Type:         schema.TypeString,
+				ValidateFunc: ValidateDBaaSFirewallIP,
 			},
+			Set: HashDBaaSFirewallIP,
 		},
 	}
 }